@@ -0,0 +1,258 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"go-transfers/db"
+	"go-transfers/proto"
+	"math"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/gookit/slog"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	minWebhookSecretLength = 16
+	maxWebhookDeliveries   = 100
+)
+
+// AdminServer serves the internal admin api on its own listener, that must not be exposed publicly.
+type AdminServer struct {
+	proto.UnimplementedAdminServiceServer
+	listenAddr string
+	repository AdminRepository
+}
+
+type AdminRepository interface {
+	CreateWebhook(ctx context.Context, webhook db.Webhook) (int64, error)
+	GetWebhooks(ctx context.Context) ([]db.Webhook, error)
+	DeleteWebhook(ctx context.Context, id int64) (bool, error)
+	GetWebhookDeliveries(ctx context.Context, webhookId int64, limit int) ([]db.WebhookDelivery, error)
+	CreateApiKey(ctx context.Context, key db.ApiKey) (int64, error)
+	GetApiKeys(ctx context.Context) ([]db.ApiKey, error)
+	DeleteApiKey(ctx context.Context, id int64) (bool, error)
+}
+
+func NewAdminServer(listenAddr string, repository AdminRepository) *AdminServer {
+	return &AdminServer{
+		listenAddr: listenAddr,
+		repository: repository,
+	}
+}
+
+func (s *AdminServer) CreateWebhook(ctx context.Context, request *proto.CreateWebhookRequest) (*proto.Webhook, error) {
+	webhookUrl, err := url.Parse(request.GetUrl())
+	if err != nil || (webhookUrl.Scheme != "http" && webhookUrl.Scheme != "https") || webhookUrl.Host == "" {
+		return nil, invalidArgument(ctx, "url", errors.New("expected absolute http(s) url"))
+	}
+	secret := request.GetSecret()
+	if secret == "" {
+		secret, err = generateSecret()
+		if err != nil {
+			return nil, status.Error(codes.Internal, "error generating secret")
+		}
+	}
+	if len(secret) < minWebhookSecretLength {
+		return nil, invalidArgument(ctx, "secret", errors.Errorf("less than [%d] characters", minWebhookSecretLength))
+	}
+	filter, err := transferFilter(ctx, request.GetIdentities(), request.GetAssets())
+	if err != nil {
+		return nil, err
+	}
+	webhook := db.Webhook{
+		Url:        webhookUrl.String(),
+		Secret:     secret,
+		Identities: filter.Identities,
+		MinAmount:  request.GetMinAmount(),
+	}
+	for _, asset := range filter.Assets {
+		webhook.Assets = append(webhook.Assets, asset.Issuer+"/"+asset.Name)
+	}
+	for _, eventType := range request.GetEventTypes() {
+		if eventType != 0 && eventType != 2 && eventType != 3 {
+			return nil, invalidArgument(ctx, "event_types", errors.Errorf("unsupported event type [%d]", eventType))
+		}
+		webhook.EventTypes = append(webhook.EventTypes, int64(eventType))
+	}
+
+	webhook.Id, err = s.repository.CreateWebhook(ctx, webhook)
+	if err != nil {
+		return nil, adminError("creating webhook", err)
+	}
+	webhook.Enabled = true
+	slog.Info("Created webhook.", "id", webhook.Id, "url", webhook.Url)
+
+	response := toWebhook(webhook)
+	response.Secret = secret // only returned once
+	return response, nil
+}
+
+func (s *AdminServer) GetWebhooks(ctx context.Context, _ *emptypb.Empty) (*proto.WebhooksResponse, error) {
+	webhooks, err := s.repository.GetWebhooks(ctx)
+	if err != nil {
+		return nil, adminError("getting webhooks", err)
+	}
+	response := proto.WebhooksResponse{}
+	for _, webhook := range webhooks {
+		response.Webhooks = append(response.Webhooks, toWebhook(webhook))
+	}
+	return &response, nil
+}
+
+func (s *AdminServer) DeleteWebhook(ctx context.Context, request *proto.WebhookRequest) (*emptypb.Empty, error) {
+	found, err := s.repository.DeleteWebhook(ctx, int64(request.GetId()))
+	if err != nil {
+		return nil, adminError("deleting webhook", err)
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "webhook [%d] not found", request.GetId())
+	}
+	slog.Info("Deleted webhook.", "id", request.GetId())
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AdminServer) GetWebhookDeliveries(ctx context.Context, request *proto.WebhookRequest) (*proto.WebhookDeliveriesResponse, error) {
+	deliveries, err := s.repository.GetWebhookDeliveries(ctx, int64(request.GetId()), maxWebhookDeliveries)
+	if err != nil {
+		return nil, adminError("getting webhook deliveries", err)
+	}
+	response := proto.WebhookDeliveriesResponse{}
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, &proto.WebhookDelivery{
+			NotificationId: uint64(delivery.OutboxId),
+			EventId:        uint64(delivery.EventId),
+			Attempt:        uint32(delivery.Attempt),
+			StatusCode:     uint32(delivery.StatusCode),
+			Error:          delivery.Error,
+			DurationMs:     uint64(delivery.DurationMs),
+			Timestamp:      uint64(delivery.CreatedAt.UnixMilli()),
+		})
+	}
+	return &response, nil
+}
+
+func (s *AdminServer) CreateApiKey(ctx context.Context, request *proto.CreateApiKeyRequest) (*proto.ApiKey, error) {
+	if strings.TrimSpace(request.GetName()) == "" {
+		return nil, invalidArgument(ctx, "name", errors.New("empty"))
+	}
+	if request.GetRequestsPerSecond() < 0 {
+		return nil, invalidArgument(ctx, "requests_per_second", errors.New("negative"))
+	}
+	if request.GetDailyQuota() > math.MaxInt64 {
+		return nil, invalidArgument(ctx, "daily_quota", errors.New("too large"))
+	}
+	key, err := GenerateApiKey()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "error generating api key")
+	}
+	apiKey := db.ApiKey{
+		Name:              request.GetName(),
+		KeyHash:           HashApiKey(key),
+		KeyPrefix:         key[:len(apiKeyPrefix)+4],
+		RequestsPerSecond: request.GetRequestsPerSecond(),
+		Burst:             int(request.GetBurst()),
+		DailyQuota:        int64(request.GetDailyQuota()),
+		Enabled:           true,
+	}
+	apiKey.Id, err = s.repository.CreateApiKey(ctx, apiKey)
+	if err != nil {
+		return nil, adminError("creating api key", err)
+	}
+	slog.Info("Created api key.", "id", apiKey.Id, "name", apiKey.Name)
+
+	response := toApiKey(apiKey)
+	response.Key = key // only returned once
+	return response, nil
+}
+
+func (s *AdminServer) GetApiKeys(ctx context.Context, _ *emptypb.Empty) (*proto.ApiKeysResponse, error) {
+	keys, err := s.repository.GetApiKeys(ctx)
+	if err != nil {
+		return nil, adminError("getting api keys", err)
+	}
+	response := proto.ApiKeysResponse{}
+	for _, key := range keys {
+		response.Keys = append(response.Keys, toApiKey(key))
+	}
+	return &response, nil
+}
+
+func (s *AdminServer) DeleteApiKey(ctx context.Context, request *proto.ApiKeyRequest) (*emptypb.Empty, error) {
+	found, err := s.repository.DeleteApiKey(ctx, int64(request.GetId()))
+	if err != nil {
+		return nil, adminError("deleting api key", err)
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "api key [%d] not found", request.GetId())
+	}
+	slog.Info("Deleted api key.", "id", request.GetId())
+	return &emptypb.Empty{}, nil
+}
+
+func toApiKey(key db.ApiKey) *proto.ApiKey {
+	return &proto.ApiKey{
+		Id:                uint64(key.Id),
+		Name:              key.Name,
+		KeyPrefix:         key.KeyPrefix,
+		RequestsPerSecond: key.RequestsPerSecond,
+		Burst:             uint32(key.Burst),
+		DailyQuota:        uint64(key.DailyQuota),
+		Enabled:           key.Enabled,
+	}
+}
+
+func toWebhook(webhook db.Webhook) *proto.Webhook {
+	response := proto.Webhook{
+		Id:         uint64(webhook.Id),
+		Url:        webhook.Url,
+		Identities: webhook.Identities,
+		MinAmount:  webhook.MinAmount,
+		Enabled:    webhook.Enabled,
+	}
+	for _, asset := range webhook.Assets {
+		issuer, name, _ := strings.Cut(asset, "/")
+		response.Assets = append(response.Assets, &proto.AssetKey{Issuer: issuer, Name: name})
+	}
+	for _, eventType := range webhook.EventTypes {
+		response.EventTypes = append(response.EventTypes, uint32(eventType))
+	}
+	return &response
+}
+
+func generateSecret() (string, error) {
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	return hex.EncodeToString(secret), err
+}
+
+func adminError(message string, err error) error {
+	slog.Error(message, "error", err)
+	return status.Errorf(codes.Internal, "error %s", message)
+}
+
+func (s *AdminServer) Start() error {
+	srv := grpc.NewServer()
+	proto.RegisterAdminServiceServer(srv, s)
+	reflection.Register(srv)
+
+	lis, err := net.Listen("tcp", s.listenAddr)
+	if err != nil {
+		return errors.Wrapf(err, "listening on [%s]", s.listenAddr)
+	}
+
+	go func() {
+		if err := srv.Serve(lis); err != nil {
+			panic(err)
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,138 @@
+package api
+
+import (
+	"context"
+	"go-transfers/db"
+	"go-transfers/proto"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type FakeAdminRepository struct {
+}
+
+func (f FakeAdminRepository) CreateWebhook(_ context.Context, _ db.Webhook) (int64, error) {
+	return 42, nil
+}
+
+func (f FakeAdminRepository) GetWebhooks(_ context.Context) ([]db.Webhook, error) {
+	return []db.Webhook{{Id: 42, Url: "https://example.org", Secret: "secret", Assets: []string{"ISSUER/NAME"}, EventTypes: []int64{2}, Enabled: true}}, nil
+}
+
+func (f FakeAdminRepository) DeleteWebhook(_ context.Context, id int64) (bool, error) {
+	return id == 42, nil
+}
+
+func (f FakeAdminRepository) GetWebhookDeliveries(_ context.Context, _ int64, _ int) ([]db.WebhookDelivery, error) {
+	return []db.WebhookDelivery{{OutboxId: 1, Attempt: 1, StatusCode: 200, CreatedAt: time.Now()}}, nil
+}
+
+func (f FakeAdminRepository) CreateApiKey(_ context.Context, _ db.ApiKey) (int64, error) {
+	return 7, nil
+}
+
+func (f FakeAdminRepository) GetApiKeys(_ context.Context) ([]db.ApiKey, error) {
+	return []db.ApiKey{{Id: 7, Name: "exchange", KeyHash: "hash", KeyPrefix: "qtr_abcd", Burst: 1, Enabled: true}}, nil
+}
+
+func (f FakeAdminRepository) DeleteApiKey(_ context.Context, id int64) (bool, error) {
+	return id == 7, nil
+}
+
+func adminClient(t *testing.T) proto.AdminServiceClient {
+	conn, err := grpc.NewClient("localhost:8083", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return proto.NewAdminServiceClient(conn)
+}
+
+func TestAdminServer_CreateWebhook(t *testing.T) {
+	webhook, err := adminClient(t).CreateWebhook(context.Background(), &proto.CreateWebhookRequest{
+		Url:        "https://example.org/hook",
+		Identities: []string{"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB"},
+		EventTypes: []uint32{0},
+		MinAmount:  math.MaxUint64,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), webhook.GetId())
+	assert.Equal(t, uint64(math.MaxUint64), webhook.GetMinAmount())
+	assert.Len(t, webhook.GetSecret(), 64) // generated
+	assert.True(t, webhook.GetEnabled())
+}
+
+func TestAdminServer_CreateWebhook_givenInvalidArguments_thenInvalidArgument(t *testing.T) {
+	client := adminClient(t)
+	for _, request := range []*proto.CreateWebhookRequest{
+		{Url: "example.org"},
+		{Url: "ftp://example.org"},
+		{Url: "https://example.org", Secret: "short"},
+		{Url: "https://example.org", Identities: []string{"BLAH"}},
+		{Url: "https://example.org", EventTypes: []uint32{1}},
+	} {
+		_, err := client.CreateWebhook(context.Background(), request)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), request.String())
+	}
+}
+
+func TestAdminServer_GetWebhooks_thenDoNotReturnSecret(t *testing.T) {
+	response, err := adminClient(t).GetWebhooks(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Len(t, response.GetWebhooks(), 1)
+	webhook := response.GetWebhooks()[0]
+	assert.Empty(t, webhook.GetSecret())
+	assert.Equal(t, "NAME", webhook.GetAssets()[0].GetName())
+	assert.Equal(t, []uint32{2}, webhook.GetEventTypes())
+}
+
+func TestAdminServer_DeleteWebhook_givenUnknown_thenNotFound(t *testing.T) {
+	_, err := adminClient(t).DeleteWebhook(context.Background(), &proto.WebhookRequest{Id: 1})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = adminClient(t).DeleteWebhook(context.Background(), &proto.WebhookRequest{Id: 42})
+	assert.NoError(t, err)
+}
+
+func TestAdminServer_GetWebhookDeliveries(t *testing.T) {
+	response, err := adminClient(t).GetWebhookDeliveries(context.Background(), &proto.WebhookRequest{Id: 42})
+	require.NoError(t, err)
+	require.Len(t, response.GetDeliveries(), 1)
+	assert.Equal(t, uint32(200), response.GetDeliveries()[0].GetStatusCode())
+}
+
+func TestAdminServer_CreateApiKey(t *testing.T) {
+	key, err := adminClient(t).CreateApiKey(context.Background(), &proto.CreateApiKeyRequest{Name: "exchange", RequestsPerSecond: 10, Burst: 20})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), key.GetId())
+	assert.True(t, strings.HasPrefix(key.GetKey(), "qtr_"))
+	assert.Len(t, key.GetKey(), 52)
+	assert.Equal(t, key.GetKey()[:8], key.GetKeyPrefix())
+	assert.Equal(t, float64(10), key.GetRequestsPerSecond())
+
+	_, err = adminClient(t).CreateApiKey(context.Background(), &proto.CreateApiKeyRequest{Name: " "})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAdminServer_GetApiKeys_thenDoNotReturnKeys(t *testing.T) {
+	response, err := adminClient(t).GetApiKeys(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Len(t, response.GetKeys(), 1)
+	assert.Equal(t, "exchange", response.GetKeys()[0].GetName())
+	assert.Empty(t, response.GetKeys()[0].GetKey())
+}
+
+func TestAdminServer_DeleteApiKey(t *testing.T) {
+	_, err := adminClient(t).DeleteApiKey(context.Background(), &proto.ApiKeyRequest{Id: 7})
+	require.NoError(t, err)
+	_, err = adminClient(t).DeleteApiKey(context.Background(), &proto.ApiKeyRequest{Id: 8})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
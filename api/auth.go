@@ -0,0 +1,315 @@
+package api
+
+import (
+	"container/list"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"go-transfers/db"
+	"go-transfers/proto"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gookit/slog"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	ApiKeyHeader          = "x-api-key"
+	apiKeyPrefix          = "qtr_"
+	anonymousKeyName      = "anonymous"
+	unknownKeyName        = "unknown"
+	resultAllowed         = "allowed"
+	resultUnauthenticated = "unauthenticated"
+	resultRateLimited     = "rate_limited"
+	resultQuotaExceeded   = "quota_exceeded"
+	maxAnonymousClients   = 10000
+)
+
+type ApiKeyRepository interface {
+	GetApiKeys(ctx context.Context) ([]db.ApiKey, error)
+}
+
+type RequestCounter interface {
+	CountApiRequest(key, result string)
+}
+
+// KeyAuthenticator checks the api keys of the requests and applies the per key rate limits and daily quotas. The keys
+// are cached and refreshed periodically. Quota usage is kept in memory and starts from zero after a restart. Requests
+// without key are limited per client address. The limiters of the least recently seen clients are evicted.
+type KeyAuthenticator struct {
+	repository        ApiKeyRepository
+	counter           RequestCounter
+	required          bool
+	anonymousRate     float64
+	anonymousBurst    int
+	anonymousCapacity int
+	mutex             sync.Mutex
+	keys              map[string]*keyState // by key hash
+	anonymous         map[string]*list.Element
+	anonymousOrder    *list.List // least recently used last
+	now               func() time.Time
+}
+
+type keyState struct {
+	key     db.ApiKey
+	limiter *rate.Limiter
+	day     string // utc day of the quota usage
+	used    int64
+}
+
+type anonymousClient struct {
+	address string
+	limiter *rate.Limiter
+}
+
+// NewKeyAuthenticator creates an authenticator. If keys are not required, requests without key are limited by the
+// anonymous rate (0 means unlimited) per client address.
+func NewKeyAuthenticator(repository ApiKeyRepository, counter RequestCounter, required bool, anonymousRate float64, anonymousBurst int) *KeyAuthenticator {
+	return &KeyAuthenticator{
+		repository:        repository,
+		counter:           counter,
+		required:          required,
+		anonymousRate:     anonymousRate,
+		anonymousBurst:    anonymousBurst,
+		anonymousCapacity: maxAnonymousClients,
+		keys:              map[string]*keyState{},
+		anonymous:         map[string]*list.Element{},
+		anonymousOrder:    list.New(),
+		now:               time.Now,
+	}
+}
+
+func (a *KeyAuthenticator) RefreshInLoop(interval time.Duration) {
+	loopTick := time.Tick(interval)
+	for range loopTick {
+		err := a.Refresh(context.Background())
+		if err != nil {
+			slog.Error("refreshing api keys", "err", err.Error())
+		}
+	}
+}
+
+// Refresh reloads the enabled keys. The state of unchanged keys is kept.
+func (a *KeyAuthenticator) Refresh(ctx context.Context) error {
+	keys, err := a.repository.GetApiKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting api keys")
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	refreshed := make(map[string]*keyState, len(keys))
+	for _, key := range keys {
+		if !key.Enabled {
+			continue
+		}
+		state, ok := a.keys[key.KeyHash]
+		if !ok || state.key != key {
+			state = &keyState{key: key, limiter: newLimiter(key.RequestsPerSecond, key.Burst)}
+		}
+		refreshed[key.KeyHash] = state
+	}
+	a.keys = refreshed
+	return nil
+}
+
+// authorize checks the key and consumes one request of its limits. Requests without key consume one request of the
+// limit of the client address.
+func (a *KeyAuthenticator) authorize(apiKey, clientAddress string) error {
+	now := a.now()
+	if apiKey == "" {
+		if a.required {
+			a.counter.CountApiRequest(anonymousKeyName, resultUnauthenticated)
+			return status.Error(codes.Unauthenticated, "api key required")
+		}
+		return a.limit(anonymousKeyName, a.anonymousLimiter(clientAddress), now)
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	state, ok := a.keys[HashApiKey(apiKey)]
+	if !ok {
+		a.counter.CountApiRequest(unknownKeyName, resultUnauthenticated)
+		return status.Error(codes.Unauthenticated, "invalid api key")
+	}
+	day := now.UTC().Format(time.DateOnly)
+	if state.day != day {
+		state.day = day
+		state.used = 0
+	}
+	if state.key.DailyQuota > 0 && state.used >= state.key.DailyQuota {
+		a.counter.CountApiRequest(state.key.Name, resultQuotaExceeded)
+		midnight := now.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+		return resourceExhausted("daily quota exceeded", midnight.Sub(now))
+	}
+	err := a.limit(state.key.Name, state.limiter, now)
+	if err == nil {
+		state.used++
+	}
+	return err
+}
+
+// anonymousLimiter returns the limiter of the client or nil, if anonymous requests are unlimited.
+func (a *KeyAuthenticator) anonymousLimiter(clientAddress string) *rate.Limiter {
+	if a.anonymousRate <= 0 {
+		return nil
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if element, ok := a.anonymous[clientAddress]; ok {
+		a.anonymousOrder.MoveToFront(element)
+		return element.Value.(*anonymousClient).limiter
+	}
+	client := &anonymousClient{address: clientAddress, limiter: newLimiter(a.anonymousRate, a.anonymousBurst)}
+	a.anonymous[clientAddress] = a.anonymousOrder.PushFront(client)
+	if a.anonymousOrder.Len() > a.anonymousCapacity {
+		oldest := a.anonymousOrder.Back()
+		a.anonymousOrder.Remove(oldest)
+		delete(a.anonymous, oldest.Value.(*anonymousClient).address)
+	}
+	return client.limiter
+}
+
+func (a *KeyAuthenticator) limit(name string, limiter *rate.Limiter, now time.Time) error {
+	if limiter != nil {
+		reservation := limiter.ReserveN(now, 1)
+		delay := reservation.DelayFrom(now)
+		if delay > 0 {
+			reservation.CancelAt(now)
+			a.counter.CountApiRequest(name, resultRateLimited)
+			return resourceExhausted("rate limit exceeded", delay)
+		}
+	}
+	a.counter.CountApiRequest(name, resultAllowed)
+	return nil
+}
+
+func (a *KeyAuthenticator) authorizeContext(ctx context.Context, method string) error {
+	if method == proto.TransferService_Health_FullMethodName || strings.HasPrefix(method, "/grpc.reflection.") || strings.HasPrefix(method, "/grpc.health.") {
+		return nil
+	}
+	var apiKey string
+	if values := metadata.ValueFromIncomingContext(ctx, ApiKeyHeader); len(values) > 0 {
+		apiKey = values[0]
+	}
+	return a.authorize(apiKey, clientAddress(ctx))
+}
+
+// clientAddress returns the ip address of the caller. The gateway connects in memory and passes the address of the
+// http client as last x-forwarded-for entry. Forwarded addresses of network callers are not trusted.
+func clientAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	if p.Addr.Network() == gatewayNetwork {
+		if forwarded := metadata.ValueFromIncomingContext(ctx, "x-forwarded-for"); len(forwarded) > 0 {
+			entries := strings.Split(forwarded[len(forwarded)-1], ",")
+			return strings.TrimSpace(entries[len(entries)-1])
+		}
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// remoteHost returns the ip address of the http client.
+func remoteHost(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func (a *KeyAuthenticator) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := a.authorizeContext(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (a *KeyAuthenticator) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := a.authorizeContext(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// httpHandler authorizes requests to http handlers, that don't pass the grpc server.
+func (a *KeyAuthenticator) httpHandler(mux *runtime.ServeMux, marshaler runtime.Marshaler, next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		if err := a.authorize(r.Header.Get(ApiKeyHeader), remoteHost(r)); err != nil {
+			runtime.HTTPError(r.Context(), mux, marshaler, w, r, err)
+			return
+		}
+		next(w, r, pathParams)
+	}
+}
+
+// incomingHeaderMatcher forwards the api key and request id headers to the grpc server.
+func incomingHeaderMatcher(key string) (string, bool) {
+	switch {
+	case strings.EqualFold(key, ApiKeyHeader):
+		return ApiKeyHeader, true
+	case strings.EqualFold(key, RequestIdHeader):
+		return RequestIdHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// retryAfterErrorHandler sets the Retry-After header, if the error contains a retry delay.
+func retryAfterErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	for _, detail := range status.Convert(err).Details() {
+		if retryInfo, ok := detail.(*errdetails.RetryInfo); ok {
+			seconds := (retryInfo.GetRetryDelay().AsDuration() + time.Second - 1) / time.Second // round up
+			w.Header().Set("Retry-After", strconv.FormatInt(int64(seconds), 10))
+		}
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
+
+func resourceExhausted(message string, delay time.Duration) error {
+	return errorWithDetails(codes.ResourceExhausted, message, &errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+}
+
+// newLimiter returns a token bucket limiter or nil, if the rate is unlimited.
+func newLimiter(requestsPerSecond float64, burst int) *rate.Limiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(requestsPerSecond), max(burst, 1))
+}
+
+// GenerateApiKey returns a new random api key.
+func GenerateApiKey() (string, error) {
+	key := make([]byte, 24)
+	_, err := rand.Read(key)
+	return apiKeyPrefix + hex.EncodeToString(key), err
+}
+
+// HashApiKey returns the hex encoded sha256 hash, that is stored instead of the key.
+func HashApiKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
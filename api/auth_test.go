@@ -0,0 +1,211 @@
+package api
+
+import (
+	"context"
+	"go-transfers/broadcast"
+	"go-transfers/db"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type FakeApiKeyRepository struct {
+	keys []db.ApiKey
+}
+
+func (f *FakeApiKeyRepository) GetApiKeys(_ context.Context) ([]db.ApiKey, error) {
+	return f.keys, nil
+}
+
+type FakeRequestCounter struct {
+	mutex  sync.Mutex
+	counts map[string]int
+}
+
+func (f *FakeRequestCounter) CountApiRequest(key, result string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.counts == nil {
+		f.counts = map[string]int{}
+	}
+	f.counts[key+"/"+result]++
+}
+
+func newTestAuthenticator(t *testing.T, required bool, keys ...db.ApiKey) (*KeyAuthenticator, *FakeRequestCounter) {
+	counter := &FakeRequestCounter{}
+	auth := NewKeyAuthenticator(&FakeApiKeyRepository{keys: keys}, counter, required, 0, 0)
+	require.NoError(t, auth.Refresh(context.Background()))
+	return auth, counter
+}
+
+// startAuthenticatedServer starts a server on other ports, that requires api keys.
+func startAuthenticatedServer() error {
+	auth := NewKeyAuthenticator(&FakeApiKeyRepository{keys: []db.ApiKey{
+		{Id: 1, Name: "limited", KeyHash: HashApiKey("qtr_limited"), RequestsPerSecond: 0.001, Burst: 1, Enabled: true},
+		{Id: 2, Name: "unlimited", KeyHash: HashApiKey("qtr_unlimited"), Enabled: true},
+	}}, &FakeRequestCounter{}, true, 0, 0)
+	if err := auth.Refresh(context.Background()); err != nil {
+		return err
+	}
+	return NewServer("0.0.0.0:8084", "0.0.0.0:8085", &FakeRepository{}, broadcast.NewTickBroadcaster(), auth, nil, nil, nil, nil).Start()
+}
+
+func TestKeyAuthenticator_Authorize_givenValidKey_thenAllow(t *testing.T) {
+	auth, counter := newTestAuthenticator(t, true, db.ApiKey{Name: "test", KeyHash: HashApiKey("qtr_key"), Enabled: true})
+	assert.NoError(t, auth.authorize("qtr_key", ""))
+	assert.Equal(t, 1, counter.counts["test/allowed"])
+}
+
+func TestKeyAuthenticator_Authorize_givenUnknownOrDisabledKey_thenUnauthenticated(t *testing.T) {
+	auth, counter := newTestAuthenticator(t, false, db.ApiKey{Name: "test", KeyHash: HashApiKey("qtr_key"), Enabled: false})
+	assert.Equal(t, codes.Unauthenticated, status.Code(auth.authorize("qtr_key", "")))
+	assert.Equal(t, codes.Unauthenticated, status.Code(auth.authorize("qtr_other", "")))
+	assert.Equal(t, 2, counter.counts["unknown/unauthenticated"])
+}
+
+func TestKeyAuthenticator_Authorize_givenNoKey(t *testing.T) {
+	auth, _ := newTestAuthenticator(t, true)
+	assert.Equal(t, codes.Unauthenticated, status.Code(auth.authorize("", "")))
+
+	auth, counter := newTestAuthenticator(t, false)
+	assert.NoError(t, auth.authorize("", ""))
+	assert.Equal(t, 1, counter.counts["anonymous/allowed"])
+}
+
+func TestKeyAuthenticator_Authorize_givenRateExceeded_thenResourceExhaustedWithRetryInfo(t *testing.T) {
+	auth, counter := newTestAuthenticator(t, true, db.ApiKey{Name: "test", KeyHash: HashApiKey("qtr_key"), RequestsPerSecond: 1, Burst: 2, Enabled: true})
+	now := time.Now()
+	auth.now = func() time.Time { return now }
+
+	assert.NoError(t, auth.authorize("qtr_key", ""))
+	assert.NoError(t, auth.authorize("qtr_key", ""))
+	err := auth.authorize("qtr_key", "")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Len(t, status.Convert(err).Details(), 1)
+	retryInfo := status.Convert(err).Details()[0].(*errdetails.RetryInfo)
+	assert.Equal(t, time.Second, retryInfo.GetRetryDelay().AsDuration())
+	assert.Equal(t, 1, counter.counts["test/rate_limited"])
+
+	now = now.Add(time.Second)
+	assert.NoError(t, auth.authorize("qtr_key", ""))
+}
+
+func TestKeyAuthenticator_Authorize_givenQuotaExceeded_thenResetNextDay(t *testing.T) {
+	auth, counter := newTestAuthenticator(t, true, db.ApiKey{Name: "test", KeyHash: HashApiKey("qtr_key"), DailyQuota: 2, Enabled: true})
+	now := time.Date(2025, time.March, 14, 23, 0, 0, 0, time.UTC)
+	auth.now = func() time.Time { return now }
+
+	assert.NoError(t, auth.authorize("qtr_key", ""))
+	assert.NoError(t, auth.authorize("qtr_key", ""))
+	err := auth.authorize("qtr_key", "")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	retryInfo := status.Convert(err).Details()[0].(*errdetails.RetryInfo)
+	assert.Equal(t, time.Hour, retryInfo.GetRetryDelay().AsDuration())
+	assert.Equal(t, 1, counter.counts["test/quota_exceeded"])
+
+	now = now.Add(time.Hour)
+	assert.NoError(t, auth.authorize("qtr_key", ""))
+}
+
+func TestKeyAuthenticator_Authorize_givenAnonymousRateExceeded_thenLimitPerClient(t *testing.T) {
+	counter := &FakeRequestCounter{}
+	auth := NewKeyAuthenticator(&FakeApiKeyRepository{}, counter, false, 1, 1)
+	now := time.Now()
+	auth.now = func() time.Time { return now }
+
+	assert.NoError(t, auth.authorize("", "10.0.0.1"))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(auth.authorize("", "10.0.0.1")))
+	assert.NoError(t, auth.authorize("", "10.0.0.2")) // other client
+	assert.Equal(t, 1, counter.counts["anonymous/rate_limited"])
+	assert.Equal(t, 2, counter.counts["anonymous/allowed"])
+}
+
+func TestKeyAuthenticator_Authorize_givenTooManyAnonymousClients_thenEvictLeastRecentlyUsed(t *testing.T) {
+	auth := NewKeyAuthenticator(&FakeApiKeyRepository{}, &FakeRequestCounter{}, false, 1, 1)
+	auth.anonymousCapacity = 2
+	now := time.Now()
+	auth.now = func() time.Time { return now }
+
+	assert.NoError(t, auth.authorize("", "10.0.0.1"))
+	assert.NoError(t, auth.authorize("", "10.0.0.2"))
+	assert.Error(t, auth.authorize("", "10.0.0.1"))   // used recently
+	assert.NoError(t, auth.authorize("", "10.0.0.3")) // evicts 10.0.0.2
+
+	assert.Len(t, auth.anonymous, 2)
+	assert.NotContains(t, auth.anonymous, "10.0.0.2")
+	assert.Error(t, auth.authorize("", "10.0.0.1"))
+}
+
+func Test_ClientAddress(t *testing.T) {
+	forwarded := metadata.Pairs("x-forwarded-for", "1.1.1.1, 10.0.0.1")
+	gateway := peer.NewContext(context.Background(), &peer.Peer{Addr: bufconn.Listen(1).Addr()})
+	local := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1234}})
+	remote := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 2), Port: 1234}})
+
+	assert.Equal(t, "10.0.0.1", clientAddress(metadata.NewIncomingContext(gateway, forwarded)))
+	assert.Equal(t, "127.0.0.1", clientAddress(metadata.NewIncomingContext(local, forwarded))) // not trusted
+	assert.Equal(t, "10.0.0.2", clientAddress(metadata.NewIncomingContext(remote, forwarded)))
+	assert.Equal(t, "10.0.0.2", clientAddress(remote))
+}
+
+func TestKeyAuthenticator_Refresh_givenUnchangedKey_thenKeepState(t *testing.T) {
+	auth, _ := newTestAuthenticator(t, true, db.ApiKey{Name: "test", KeyHash: HashApiKey("qtr_key"), DailyQuota: 1, Enabled: true})
+	assert.NoError(t, auth.authorize("qtr_key", ""))
+	require.NoError(t, auth.Refresh(context.Background()))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(auth.authorize("qtr_key", "")))
+}
+
+func TestKeyAuthenticator_AuthorizeContext_givenHealth_thenSkip(t *testing.T) {
+	auth, _ := newTestAuthenticator(t, true, db.ApiKey{Name: "test", KeyHash: HashApiKey("qtr_key"), Enabled: true})
+	assert.NoError(t, auth.authorizeContext(context.Background(), "/qubic.transfers.proto.TransferService/Health"))
+	assert.Equal(t, codes.Unauthenticated, status.Code(auth.authorizeContext(context.Background(), "/qubic.transfers.proto.TransferService/GetAssets")))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ApiKeyHeader, "qtr_key"))
+	assert.NoError(t, auth.authorizeContext(ctx, "/qubic.transfers.proto.TransferService/GetAssets"))
+}
+
+func TestServer_givenApiKeyRequired(t *testing.T) {
+	callServiceVerifyStatus(t, "http://localhost:8085/api/v1/assets", http.StatusUnauthorized)
+	callServiceVerifyStatus(t, "http://localhost:8085/status/health", http.StatusOK)
+	callServiceVerifyStatus(t, "http://localhost:8085/api/v1/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/export", http.StatusUnauthorized)
+
+	request, err := http.NewRequest(http.MethodGet, "http://localhost:8085/api/v1/assets", nil)
+	require.NoError(t, err)
+	request.Header.Set("X-Api-Key", "qtr_unlimited")
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	_ = response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+}
+
+func TestServer_givenRateLimitExceeded_thenTooManyRequests(t *testing.T) {
+	get := func() *http.Response {
+		request, err := http.NewRequest(http.MethodGet, "http://localhost:8085/api/v1/assets", nil)
+		require.NoError(t, err)
+		request.Header.Set("X-Api-Key", "qtr_limited")
+		response, err := http.DefaultClient.Do(request)
+		require.NoError(t, err)
+		_ = response.Body.Close()
+		return response
+	}
+	assert.Equal(t, http.StatusOK, get().StatusCode)
+	response := get()
+	assert.Equal(t, http.StatusTooManyRequests, response.StatusCode)
+	assert.NotEmpty(t, response.Header.Get("Retry-After"))
+}
+
+func Test_HashApiKey(t *testing.T) {
+	// echo -n 'qtr_key' | sha256sum
+	assert.Equal(t, "5492846a8ccba5eb4a70f1b586eb41ce2d44a1f902e3848c67edbb4d40dc2d8d", HashApiKey("qtr_key"))
+}
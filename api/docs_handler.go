@@ -0,0 +1,22 @@
+package api
+
+import (
+	_ "embed"
+	"go-transfers/proto"
+	"net/http"
+)
+
+//go:embed docs/index.html
+var apiExplorerPage []byte
+
+// handleOpenApiSpec serves the generated swagger spec of the rest api.
+func handleOpenApiSpec(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(proto.OpenApiSpec)
+}
+
+// handleApiExplorer serves a static page, that lists the operations of the spec and allows to send requests.
+func handleApiExplorer(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write(apiExplorerPage)
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"encoding/json"
+	"go-transfers/proto"
+	"go-transfers/proto/v2"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type openApiSpec struct {
+	Swagger string                    `json:"swagger"`
+	Paths   map[string]map[string]any `json:"paths"`
+}
+
+func TestServer_whenGetOpenApiSpec_thenReturnSpec(t *testing.T) {
+	response, err := http.Get("http://localhost:8080/api/openapi.json")
+	require.NoError(t, err)
+	body, err := readBody(response.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "application/json", response.Header.Get("Content-Type"))
+
+	var spec openApiSpec
+	require.NoError(t, json.Unmarshal(body, &spec))
+	assert.Equal(t, "2.0", spec.Swagger)
+	assert.Contains(t, spec.Paths, "/api/v1/ticks/{tick}/events/qu-transfers")
+}
+
+func TestServer_whenGetApiDocs_thenReturnExplorerPage(t *testing.T) {
+	response, err := http.Get("http://localhost:8080/api/docs")
+	require.NoError(t, err)
+	body, err := readBody(response.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Contains(t, response.Header.Get("Content-Type"), "text/html")
+	assert.Contains(t, string(body), "fetch('openapi.json')")
+}
+
+// TestOpenApiSpec_givenProto_thenContainsAllRoutes fails, if the spec was not regenerated after changing the proto.
+func TestOpenApiSpec_givenProto_thenContainsAllRoutes(t *testing.T) {
+	var spec openApiSpec
+	require.NoError(t, json.Unmarshal(proto.OpenApiSpec, &spec))
+
+	var checked int
+	for _, file := range []protoreflect.FileDescriptor{proto.File_transfers_proto, v2.File_v2_transfers_proto} {
+		checked += checkHttpRules(t, spec, file.Services().ByName("TransferService").Methods())
+	}
+	assert.Greater(t, checked, 13)
+}
+
+func checkHttpRules(t *testing.T, spec openApiSpec, methods protoreflect.MethodDescriptors) int {
+	var checked int
+	for i := 0; i < methods.Len(); i++ {
+		rule, ok := protobuf.GetExtension(methods.Get(i).Options(), annotations.E_Http).(*annotations.HttpRule)
+		if !ok || rule == nil {
+			continue
+		}
+		var method, path string
+		switch pattern := rule.GetPattern().(type) {
+		case *annotations.HttpRule_Get:
+			method, path = "get", pattern.Get
+		case *annotations.HttpRule_Post:
+			method, path = "post", pattern.Post
+		default:
+			continue
+		}
+		assert.Contains(t, spec.Paths, path, methods.Get(i).Name())
+		assert.Contains(t, spec.Paths[path], method, methods.Get(i).Name())
+		checked++
+	}
+	return checked
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gookit/slog"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+const (
+	errorDomain                = "transfers.qubic.org"
+	reasonInvalidIdentity      = "INVALID_IDENTITY"
+	reasonInvalidArgument      = "INVALID_ARGUMENT"
+	reasonTickNotYetProcessed  = "TICK_NOT_YET_PROCESSED"
+	reasonTickBeforeEpochStart = "TICK_BEFORE_EPOCH_START"
+	reasonInternalError        = "INTERNAL_ERROR"
+)
+
+// invalidIdentity reports an invalid identity in the given request field, for example the identity or the issuer.
+func invalidIdentity(ctx context.Context, field, id string) error {
+	requestId := errorRequestId(ctx)
+	slog.Error("invalid request", "field", field, "identity", id, "requestId", requestId)
+	return errorWithDetails(codes.InvalidArgument, "invalid "+field,
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: "expected 60 upper case characters with valid checksum"},
+		}},
+		&errdetails.ErrorInfo{Reason: reasonInvalidIdentity, Domain: errorDomain},
+		&errdetails.RequestInfo{RequestId: requestId},
+	)
+}
+
+func invalidArgument(ctx context.Context, field string, cause error) error {
+	requestId := errorRequestId(ctx)
+	slog.Error("invalid request", "field", field, "error", cause, "requestId", requestId)
+	return errorWithDetails(codes.InvalidArgument, "invalid "+field+": "+cause.Error(),
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: cause.Error()},
+		}},
+		&errdetails.ErrorInfo{Reason: reasonInvalidArgument, Domain: errorDomain, Metadata: map[string]string{"field": field}},
+		&errdetails.RequestInfo{RequestId: requestId},
+	)
+}
+
+// tickNotFound distinguishes ticks, that are not processed yet, from ticks in the gap before the start of the current
+// epoch, that will never be available.
+func (s *Server) tickNotFound(ctx context.Context, requested uint32, latestAvailable int) error {
+	requestId := errorRequestId(ctx)
+	epochStartTick, err := s.repository.GetEpochStartTick(ctx)
+	if err != nil {
+		slog.Error("getting epoch start tick", "error", err, "requestId", requestId)
+		epochStartTick = 0 // assume not processed yet
+	}
+	slog.Error("tick not found.", "requested:", requested, "latest:", latestAvailable, "epochStart:", epochStartTick, "requestId:", requestId)
+	reason, message := reasonTickNotYetProcessed, "tick not processed yet"
+	if int(requested) < epochStartTick {
+		reason, message = reasonTickBeforeEpochStart, "tick before start of epoch"
+	}
+	return errorWithDetails(codes.NotFound, message,
+		&errdetails.ErrorInfo{Reason: reason, Domain: errorDomain, Metadata: map[string]string{
+			"tick":           strconv.FormatUint(uint64(requested), 10),
+			"latestTick":     strconv.Itoa(latestAvailable),
+			"epochStartTick": strconv.Itoa(epochStartTick),
+		}},
+		&errdetails.RequestInfo{RequestId: requestId},
+	)
+}
+
+func retrieveEventsError(ctx context.Context, internalMessage string, args ...any) error {
+	requestId := errorRequestId(ctx)
+	slog.Error(internalMessage, "requestId", requestId, args)
+	return errorWithDetails(codes.Internal, "error retrieving events",
+		&errdetails.ErrorInfo{Reason: reasonInternalError, Domain: errorDomain},
+		&errdetails.RequestInfo{RequestId: requestId},
+	)
+}
+
+// errorRequestId returns the id of the request, that is also returned in the response headers, or a new one for
+// requests without id.
+func errorRequestId(ctx context.Context) string {
+	if requestId := RequestIdFromContext(ctx); requestId != "" {
+		return requestId
+	}
+	return uuid.New().String()
+}
+
+// errorWithDetails returns a status error with the given details. The gateway adds them to the json error body.
+func errorWithDetails(code codes.Code, message string, details ...protoadapt.MessageV1) error {
+	st, err := status.New(code, message).WithDetails(details...)
+	if err != nil {
+		return status.Error(code, message)
+	}
+	return st.Err()
+}
@@ -0,0 +1,100 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func errorInfo(t *testing.T, err error) *errdetails.ErrorInfo {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+	require.Fail(t, "missing error info")
+	return nil
+}
+
+func TestServer_TickNotFound(t *testing.T) {
+	server := NewServer("", "", &FakeRepository{}, nil, nil, nil, nil, nil, nil) // epoch starts at 2000
+
+	err := server.tickNotFound(context.Background(), 3000, 1234)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, reasonTickNotYetProcessed, errorInfo(t, err).GetReason())
+	assert.Equal(t, "1234", errorInfo(t, err).GetMetadata()["latestTick"])
+
+	err = server.tickNotFound(context.Background(), 1500, 1234)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, reasonTickBeforeEpochStart, errorInfo(t, err).GetReason())
+	assert.Equal(t, "2000", errorInfo(t, err).GetMetadata()["epochStartTick"])
+}
+
+func TestInvalidArgument_thenBadRequestDetails(t *testing.T) {
+	err := invalidArgument(context.Background(), "to_tick", errors.New("must not be before from_tick"))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "invalid to_tick: must not be before from_tick", status.Convert(err).Message())
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	var requestId string
+	for _, detail := range status.Convert(err).Details() {
+		switch d := detail.(type) {
+		case *errdetails.BadRequest:
+			violations = d.GetFieldViolations()
+		case *errdetails.RequestInfo:
+			requestId = d.GetRequestId()
+		}
+	}
+	require.Len(t, violations, 1)
+	assert.Equal(t, "to_tick", violations[0].GetField())
+	assert.NotEmpty(t, requestId)
+	assert.Equal(t, reasonInvalidArgument, errorInfo(t, err).GetReason())
+}
+
+func TestServer_whenTickNotFound_thenJsonErrorDetails(t *testing.T) {
+	response, err := http.Get("http://localhost:8080/api/v1/ticks/3000/events/qu-transfers")
+	require.NoError(t, err)
+	body, err := readBody(response.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+	assert.Contains(t, string(body), `"@type":"type.googleapis.com/google.rpc.ErrorInfo"`)
+	assert.Contains(t, string(body), `"reason":"TICK_NOT_YET_PROCESSED"`)
+	assert.Contains(t, string(body), `"@type":"type.googleapis.com/google.rpc.RequestInfo"`)
+}
+
+func TestInvalidIdentity_thenReportRequestField(t *testing.T) {
+	err := invalidIdentity(context.Background(), "issuer", "BLAH")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "invalid issuer", status.Convert(err).Message())
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, detail := range status.Convert(err).Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			violations = badRequest.GetFieldViolations()
+		}
+	}
+	require.Len(t, violations, 1)
+	assert.Equal(t, "issuer", violations[0].GetField())
+	assert.Equal(t, reasonInvalidIdentity, errorInfo(t, err).GetReason())
+}
+
+func TestRetrieveEventsError_givenRequestId_thenReturnRequestId(t *testing.T) {
+	ctx := context.WithValue(context.Background(), requestIdKey{}, "test-request-id")
+	err := retrieveEventsError(ctx, "getting events")
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	var requestId string
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.RequestInfo); ok {
+			requestId = info.GetRequestId()
+		}
+	}
+	assert.Equal(t, "test-request-id", requestId)
+}
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"go-transfers/db"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gookit/slog"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/pkg/errors"
+)
+
+const (
+	exportFormatCsv    = "csv"
+	exportFormatNdjson = "ndjson"
+)
+
+var exportCsvHeader = []string{"tick", "timestamp", "transaction_hash", "event_id", "event_type", "direction", "counterparty", "amount", "asset"}
+
+type exportRecord struct {
+	Tick            uint32 `json:"tick"`
+	Timestamp       string `json:"timestamp"`
+	TransactionHash string `json:"transactionHash"`
+	EventId         uint64 `json:"eventId"`
+	EventType       uint32 `json:"eventType"`
+	Direction       string `json:"direction"`
+	Counterparty    string `json:"counterparty"`
+	Amount          uint64 `json:"amount"`
+	Asset           string `json:"asset"`
+}
+
+// handleExport streams all transfers of the entity as csv or newline delimited json. Query parameters: format (csv,
+// ndjson. default csv), from_tick and to_tick (inclusive).
+func (s *Server) handleExport(mux *runtime.ServeMux, marshaler runtime.Marshaler) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		identity := pathParams["identity"]
+		if !isValidIdentity(identity) {
+			runtime.HTTPError(r.Context(), mux, marshaler, w, r, invalidIdentity(r.Context(), "identity", identity))
+			return
+		}
+		query := r.URL.Query()
+		format := query.Get("format")
+		if format == "" {
+			format = exportFormatCsv
+		}
+		if format != exportFormatCsv && format != exportFormatNdjson {
+			runtime.HTTPError(r.Context(), mux, marshaler, w, r, invalidArgument(r.Context(), "format", errors.Errorf("unsupported format [%s]", format)))
+			return
+		}
+		fromTick, err := parseTickParam(query.Get("from_tick"))
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, marshaler, w, r, invalidArgument(r.Context(), "from_tick", err))
+			return
+		}
+		toTick, err := parseTickParam(query.Get("to_tick"))
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, marshaler, w, r, invalidArgument(r.Context(), "to_tick", err))
+			return
+		}
+		if fromTick > 0 && toTick > 0 && fromTick > toTick {
+			runtime.HTTPError(r.Context(), mux, marshaler, w, r, invalidArgument(r.Context(), "to_tick", errors.New("must not be before from_tick")))
+			return
+		}
+		slog.Debug("Export transfers", "entity", identity, "format", format, "from", fromTick, "to", toTick)
+
+		contentType := "text/csv"
+		if format == exportFormatNdjson {
+			contentType = "application/x-ndjson"
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s-transfers.%s"`, identity, format))
+
+		buffer := bufio.NewWriter(w)
+		if format == exportFormatCsv {
+			err = s.exportCsv(r, buffer, identity, fromTick, toTick)
+		} else {
+			err = s.exportNdjson(r, buffer, identity, fromTick, toTick)
+		}
+		if err == nil {
+			err = buffer.Flush()
+		}
+		if err != nil { // status is already sent. the client gets a truncated file.
+			slog.Error("exporting transfers", "entity", identity, "error", err)
+		}
+	}
+}
+
+func (s *Server) exportCsv(r *http.Request, w *bufio.Writer, identity string, fromTick, toTick uint32) error {
+	writer := csv.NewWriter(w)
+	err := writer.Write(exportCsvHeader)
+	if err != nil {
+		return errors.Wrap(err, "writing csv header")
+	}
+	err = s.repository.ExportEventsForEntity(r.Context(), identity, fromTick, toTick, func(row *db.ExportRow) error {
+		record := toExportRecord(row)
+		return writer.Write([]string{
+			strconv.FormatUint(uint64(record.Tick), 10),
+			record.Timestamp,
+			record.TransactionHash,
+			strconv.FormatUint(record.EventId, 10),
+			strconv.FormatUint(uint64(record.EventType), 10),
+			record.Direction,
+			record.Counterparty,
+			strconv.FormatUint(record.Amount, 10),
+			record.Asset,
+		})
+	})
+	if err != nil {
+		return errors.Wrap(err, "exporting csv")
+	}
+	writer.Flush()
+	return errors.Wrap(writer.Error(), "writing csv")
+}
+
+func (s *Server) exportNdjson(r *http.Request, w *bufio.Writer, identity string, fromTick, toTick uint32) error {
+	encoder := json.NewEncoder(w) // writes one line per record
+	err := s.repository.ExportEventsForEntity(r.Context(), identity, fromTick, toTick, func(row *db.ExportRow) error {
+		return encoder.Encode(toExportRecord(row))
+	})
+	return errors.Wrap(err, "exporting ndjson")
+}
+
+func toExportRecord(row *db.ExportRow) exportRecord {
+	var timestamp string
+	if row.Timestamp.Valid {
+		timestamp = row.Timestamp.Time.UTC().Format(time.RFC3339)
+	}
+	return exportRecord{
+		Tick:            row.Tick,
+		Timestamp:       timestamp,
+		TransactionHash: row.TransactionHash,
+		EventId:         row.EventId,
+		EventType:       row.EventType,
+		Direction:       row.Direction,
+		Counterparty:    row.Counterparty,
+		Amount:          row.Amount,
+		Asset:           row.Asset,
+	}
+}
+
+// parseTickParam parses an optional tick number. Empty means 0.
+func parseTickParam(value string) (uint32, error) {
+	if value == "" {
+		return 0, nil
+	}
+	tick, err := strconv.ParseUint(value, 10, 32)
+	return uint32(tick), err
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_Export_givenCsv_thenStreamCsv(t *testing.T) {
+	response, err := http.Get("http://localhost:8080/api/v1/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/export?from_tick=10&to_tick=20")
+	require.NoError(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "text/csv", response.Header.Get("Content-Type"))
+
+	body, err := readBody(response.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "tick,timestamp,transaction_hash,event_id,event_type,direction,counterparty,amount,asset\n"+
+		"10,2025-03-14T12:30:00Z,hash,1,0,incoming,SOURCE,42,\n", string(body))
+}
+
+func TestServer_Export_givenNdjson_thenStreamJsonLines(t *testing.T) {
+	response, err := http.Get("http://localhost:8080/api/v1/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/export?format=ndjson")
+	require.NoError(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "application/x-ndjson", response.Header.Get("Content-Type"))
+
+	body, err := readBody(response.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"tick":0,"timestamp":"2025-03-14T12:30:00Z","transactionHash":"hash","eventId":1,"eventType":0,`+
+		`"direction":"incoming","counterparty":"SOURCE","amount":42,"asset":""}`+"\n", string(body))
+}
+
+func TestServer_Export_givenInvalidFormat_thenBadRequest(t *testing.T) {
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/export?format=xml", http.StatusBadRequest)
+}
+
+func TestServer_Export_givenInvalidIdentity_thenBadRequest(t *testing.T) {
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/entities/BLAH/export", http.StatusBadRequest)
+}
+
+func TestServer_Export_givenInvalidTickRange_thenBadRequest(t *testing.T) {
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/export?from_tick=20&to_tick=10", http.StatusBadRequest)
+}
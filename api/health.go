@@ -0,0 +1,148 @@
+package api
+
+import (
+	"context"
+	"go-transfers/client"
+	"go-transfers/proto"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gookit/slog"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	statusUp              = "UP"
+	statusError           = "ERROR"
+	healthCheckTimeout    = 5 * time.Second
+	servingStatusInterval = 5 * time.Second
+	componentDatabase     = "db"
+	componentEventService = "events"
+	componentSync         = "sync"
+	transferServiceName   = "qubic.transfers.proto.TransferService"
+)
+
+type LatestTickRepository interface {
+	GetLatestTick(ctx context.Context) (int, error)
+}
+
+type EventStatusClient interface {
+	GetStatus(ctx context.Context) (*client.EventStatus, error)
+}
+
+// HealthChecker checks, if the instance is ready to serve requests. The instance is not ready, if the database or the
+// event service is not reachable or if the processed tick lags more than maxSyncLag ticks behind the event service.
+type HealthChecker struct {
+	repository LatestTickRepository
+	events     EventStatusClient // optional
+	maxSyncLag uint32
+}
+
+// NewHealthChecker creates a health checker. If events is nil, only the database is checked.
+func NewHealthChecker(repository LatestTickRepository, events EventStatusClient, maxSyncLag uint32) *HealthChecker {
+	return &HealthChecker{
+		repository: repository,
+		events:     events,
+		maxSyncLag: maxSyncLag,
+	}
+}
+
+// check returns the health of the components and the overall status.
+func (h *HealthChecker) check(ctx context.Context) *proto.HealthResponse {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	response := &proto.HealthResponse{Status: statusUp, Components: map[string]*proto.Component{}}
+	setComponent := func(name string, err error, details map[string]string) {
+		componentStatus := statusUp
+		if err != nil {
+			slog.Warn("Health check failed.", "component", name, "error", err)
+			componentStatus = statusError
+			response.Status = statusError
+		}
+		response.Components[name] = &proto.Component{Status: componentStatus, Details: details}
+	}
+
+	latestTick, dbErr := h.repository.GetLatestTick(ctx)
+	setComponent(componentDatabase, dbErr, map[string]string{"latestTick": strconv.Itoa(latestTick)})
+	if h.events == nil {
+		return response
+	}
+
+	eventStatus, eventsErr := h.events.GetStatus(ctx)
+	var availableTick uint32
+	if eventsErr == nil {
+		availableTick = eventStatus.AvailableTick
+	}
+	setComponent(componentEventService, eventsErr, map[string]string{"availableTick": strconv.FormatUint(uint64(availableTick), 10)})
+	if dbErr != nil || eventsErr != nil {
+		return response // lag is unknown
+	}
+
+	var lag uint32
+	if availableTick > uint32(latestTick) {
+		lag = availableTick - uint32(latestTick)
+	}
+	var lagErr error
+	if lag > h.maxSyncLag {
+		lagErr = errors.Errorf("sync lag [%d] exceeds [%d] ticks", lag, h.maxSyncLag)
+	}
+	setComponent(componentSync, lagErr, map[string]string{
+		"lag":    strconv.FormatUint(uint64(lag), 10),
+		"maxLag": strconv.FormatUint(uint64(h.maxSyncLag), 10),
+	})
+	return response
+}
+
+// updateServingStatus sets the status of the grpc health service according to the readiness.
+func (h *HealthChecker) updateServingStatus(healthServer *health.Server) {
+	servingStatus := healthpb.HealthCheckResponse_SERVING
+	if h.check(context.Background()).GetStatus() != statusUp {
+		servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	healthServer.SetServingStatus("", servingStatus)
+	healthServer.SetServingStatus(transferServiceName, servingStatus)
+}
+
+func (h *HealthChecker) updateServingStatusInLoop(healthServer *health.Server) {
+	loopTick := time.Tick(servingStatusInterval)
+	for range loopTick {
+		h.updateServingStatus(healthServer)
+	}
+}
+
+// handleLive answers liveness probes. The process is alive, if it answers.
+func handleLive(marshaler runtime.Marshaler) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+		writeHealth(w, marshaler, &proto.HealthResponse{Status: statusUp}, http.StatusOK)
+	}
+}
+
+// handleReady answers readiness probes. Returns service unavailable, if the instance should not get requests.
+func (h *HealthChecker) handleReady(marshaler runtime.Marshaler) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		response := h.check(r.Context())
+		code := http.StatusOK
+		if response.GetStatus() != statusUp {
+			code = http.StatusServiceUnavailable
+		}
+		writeHealth(w, marshaler, response, code)
+	}
+}
+
+func writeHealth(w http.ResponseWriter, marshaler runtime.Marshaler, response *proto.HealthResponse, code int) {
+	body, err := marshaler.Marshal(response)
+	if err != nil {
+		slog.Error("marshalling health response", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", marshaler.ContentType(response))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,100 @@
+package api
+
+import (
+	"context"
+	"go-transfers/client"
+	"net/http"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type FakeTickRepository struct {
+	tick int
+	err  error
+}
+
+func (f *FakeTickRepository) GetLatestTick(_ context.Context) (int, error) {
+	return f.tick, f.err
+}
+
+type FakeEventStatusClient struct {
+	availableTick uint32
+	err           error
+}
+
+func (f *FakeEventStatusClient) GetStatus(_ context.Context) (*client.EventStatus, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &client.EventStatus{AvailableTick: f.availableTick}, nil
+}
+
+func TestHealthChecker_Check_givenSyncedInstance_thenUp(t *testing.T) {
+	checker := NewHealthChecker(&FakeTickRepository{tick: 1000}, &FakeEventStatusClient{availableTick: 1010}, 10)
+	response := checker.check(context.Background())
+	assert.Equal(t, statusUp, response.GetStatus())
+	assert.Equal(t, statusUp, response.GetComponents()[componentEventService].GetStatus())
+	assert.Equal(t, "10", response.GetComponents()[componentSync].GetDetails()["lag"])
+}
+
+func TestHealthChecker_Check_givenSyncLagExceeded_thenError(t *testing.T) {
+	checker := NewHealthChecker(&FakeTickRepository{tick: 1000}, &FakeEventStatusClient{availableTick: 1011}, 10)
+	response := checker.check(context.Background())
+	assert.Equal(t, statusError, response.GetStatus())
+	assert.Equal(t, statusUp, response.GetComponents()[componentDatabase].GetStatus())
+	assert.Equal(t, statusError, response.GetComponents()[componentSync].GetStatus())
+	assert.Equal(t, "11", response.GetComponents()[componentSync].GetDetails()["lag"])
+}
+
+func TestHealthChecker_Check_givenUnreachableComponent_thenError(t *testing.T) {
+	checker := NewHealthChecker(&FakeTickRepository{tick: 1000}, &FakeEventStatusClient{err: errors.New("test")}, 10)
+	response := checker.check(context.Background())
+	assert.Equal(t, statusError, response.GetStatus())
+	assert.Equal(t, statusError, response.GetComponents()[componentEventService].GetStatus())
+	assert.NotContains(t, response.GetComponents(), componentSync)
+
+	checker = NewHealthChecker(&FakeTickRepository{err: errors.New("test")}, &FakeEventStatusClient{availableTick: 1000}, 10)
+	response = checker.check(context.Background())
+	assert.Equal(t, statusError, response.GetStatus())
+	assert.Equal(t, statusError, response.GetComponents()[componentDatabase].GetStatus())
+	assert.Equal(t, statusUp, response.GetComponents()[componentEventService].GetStatus())
+}
+
+func TestServer_whenLiveAndReady_thenReturnStatusUp(t *testing.T) {
+	for _, path := range []string{"/status/live", "/status/ready"} {
+		response, err := http.Get("http://localhost:8080" + path)
+		require.NoError(t, err)
+		body, err := readBody(response.Body)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, response.StatusCode, path)
+		assert.Contains(t, string(body), `"status":"UP"`, path)
+	}
+}
+
+func TestServer_whenNotReady_thenServiceUnavailable(t *testing.T) {
+	checker := NewHealthChecker(&FakeTickRepository{err: errors.New("test")}, nil, 0)
+	recorder := &responseRecorder{header: http.Header{}}
+	request, err := http.NewRequest(http.MethodGet, "/status/ready", nil)
+	require.NoError(t, err)
+	checker.handleReady(&runtime.JSONPb{})(recorder, request, nil)
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.status)
+	assert.Contains(t, recorder.body.String(), `"status":"ERROR"`)
+}
+
+func TestServer_whenGrpcHealthCheck_thenServingWithoutApiKey(t *testing.T) {
+	for _, address := range []string{"localhost:8081", "localhost:8084"} {
+		conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+		response, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{Service: transferServiceName})
+		require.NoError(t, err, address)
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, response.GetStatus(), address)
+		_ = conn.Close()
+	}
+}
@@ -0,0 +1,235 @@
+package api
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+const (
+	cacheResultHit      = "hit"
+	cacheResultMiss     = "miss"
+	maxCachedBodyLength = 1024 * 1024
+)
+
+var (
+	tickPath        = regexp.MustCompile(`^/api/(v1/ticks/\d+/events/[a-z-]+|v2/ticks/\d+/transfers)$`)
+	entityPath      = regexp.MustCompile(`^/api/v[12]/entities/[A-Z]+/[a-z/-]+$`)
+	latestTickField = regexp.MustCompile(`"latestTick":\s*\d+`)
+)
+
+type CacheCounter interface {
+	CountCacheRequest(result string)
+}
+
+// ResponseCache keeps the serialized responses of finalized ticks in memory. The events of processed ticks don't
+// change. The latest tick of the cached responses is replaced with the current one, when they are served.
+type ResponseCache struct {
+	mutex    sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List // least recently used last
+	maxAge   time.Duration
+	counter  CacheCounter
+}
+
+type cachedResponse struct {
+	key         string
+	contentType string
+	etag        string
+	body        []byte
+}
+
+// NewResponseCache creates a cache with up to capacity tick responses. Entity responses are not cached. Tick and entity
+// responses get an etag and may be cached by clients for maxAge.
+func NewResponseCache(capacity int, maxAge time.Duration, counter CacheCounter) *ResponseCache {
+	return &ResponseCache{
+		capacity: capacity,
+		entries:  map[string]*list.Element{},
+		order:    list.New(),
+		maxAge:   maxAge,
+		counter:  counter,
+	}
+}
+
+func (c *ResponseCache) get(key string) (*cachedResponse, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*cachedResponse), true
+}
+
+func (c *ResponseCache) add(response *cachedResponse) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if element, ok := c.entries[response.key]; ok {
+		element.Value = response
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[response.key] = c.order.PushFront(response)
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cachedResponse).key)
+	}
+}
+
+// handler adds etag and cache control headers to successful tick and entity responses of the mux and answers
+// conditional requests. Tick responses are served from the cache with the current latest tick. The api key is checked
+// before serving a cached response, as these requests don't reach the grpc interceptors.
+func (c *ResponseCache) handler(mux *runtime.ServeMux, marshaler runtime.Marshaler, auth *KeyAuthenticator, ticks LatestTickRepository) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			mux.ServeHTTP(w, r)
+			return
+		}
+		var finalized bool
+		switch {
+		case tickPath.MatchString(r.URL.Path):
+			finalized = true
+		case entityPath.MatchString(r.URL.Path) && !strings.HasSuffix(r.URL.Path, "/export"): // etag only
+		default:
+			mux.ServeHTTP(w, r)
+			return
+		}
+		visibility := "public"
+		if auth != nil {
+			visibility = "private" // shared caches must not serve authorized responses
+		}
+		cacheControl := fmt.Sprintf("%s, max-age=%d", visibility, int(c.maxAge.Seconds()))
+
+		key := r.URL.RequestURI()
+		var latestTick int
+		if finalized {
+			var err error
+			latestTick, err = ticks.GetLatestTick(r.Context())
+			if err != nil { // the mux reports the error
+				mux.ServeHTTP(w, r)
+				return
+			}
+			if cached, ok := c.get(key); ok {
+				if auth != nil {
+					if err := auth.authorize(r.Header.Get(ApiKeyHeader), remoteHost(r)); err != nil {
+						runtime.HTTPError(r.Context(), mux, marshaler, w, r, err)
+						return
+					}
+				}
+				c.counter.CountCacheRequest(cacheResultHit)
+				writeCached(w, r, cached.withLatestTick(latestTick), cacheControl)
+				return
+			}
+			c.counter.CountCacheRequest(cacheResultMiss)
+		}
+
+		recorder := &responseRecorder{header: http.Header{}, status: http.StatusOK}
+		mux.ServeHTTP(recorder, r)
+		if recorder.status != http.StatusOK || recorder.body.Len() > maxCachedBodyLength {
+			recorder.writeTo(w)
+			return
+		}
+		response := &cachedResponse{
+			key:         key,
+			contentType: recorder.header.Get("Content-Type"),
+			etag:        etag(recorder.body.Bytes()),
+			body:        recorder.body.Bytes(),
+		}
+		if finalized {
+			c.add(response)
+			response = response.withLatestTick(latestTick)
+		}
+		for name, values := range recorder.header {
+			w.Header()[name] = values
+		}
+		writeCached(w, r, response, cacheControl)
+	})
+}
+
+// withLatestTick returns the response with the given latest tick. The etag includes the latest tick, as it changes
+// the response.
+func (cr *cachedResponse) withLatestTick(latestTick int) *cachedResponse {
+	location := latestTickField.FindIndex(cr.body)
+	if location == nil {
+		return cr
+	}
+	body := make([]byte, 0, len(cr.body)+10)
+	body = append(body, cr.body[:location[0]]...)
+	body = append(body, `"latestTick":`...)
+	body = strconv.AppendInt(body, int64(latestTick), 10)
+	body = append(body, cr.body[location[1]:]...)
+	return &cachedResponse{
+		key:         cr.key,
+		contentType: cr.contentType,
+		etag:        strings.TrimSuffix(cr.etag, `"`) + "-" + strconv.Itoa(latestTick) + `"`,
+		body:        body,
+	}
+}
+
+func writeCached(w http.ResponseWriter, r *http.Request, response *cachedResponse, cacheControl string) {
+	w.Header().Set("ETag", response.etag)
+	w.Header().Set("Cache-Control", cacheControl)
+	if matchesEtag(r.Header.Get("If-None-Match"), response.etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", response.contentType)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(response.body)
+}
+
+func etag(body []byte) string {
+	hash := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(hash[:16]) + `"`
+}
+
+// matchesEtag checks the If-None-Match header. Weak comparison is used, as required for If-None-Match.
+func matchesEtag(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// responseRecorder buffers the response to inspect it before it is sent.
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	return r.body.Write(data)
+}
+
+func (r *responseRecorder) writeTo(w http.ResponseWriter) {
+	for name, values := range r.header {
+		w.Header()[name] = values
+	}
+	w.WriteHeader(r.status)
+	_, _ = w.Write(r.body.Bytes())
+}
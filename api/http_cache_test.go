@@ -0,0 +1,172 @@
+package api
+
+import (
+	"go-transfers/db"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type FakeCacheCounter struct {
+	mutex  sync.Mutex
+	counts map[string]int
+}
+
+func (f *FakeCacheCounter) CountCacheRequest(result string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.counts == nil {
+		f.counts = map[string]int{}
+	}
+	f.counts[result]++
+}
+
+// cachedMux returns a handler with a cache in front of a mux, that counts the served requests. The latest tick is 100.
+func cachedMux(t *testing.T, capacity int, auth *KeyAuthenticator) (http.Handler, *int, *FakeCacheCounter) {
+	handler, calls, counter, _ := cachedMuxWithTicks(t, capacity, auth)
+	return handler, calls, counter
+}
+
+func cachedMuxWithTicks(t *testing.T, capacity int, auth *KeyAuthenticator) (http.Handler, *int, *FakeCacheCounter, *FakeTickRepository) {
+	mux := runtime.NewServeMux()
+	ticks := &FakeTickRepository{tick: 100}
+	calls := 0
+	handler := func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		calls++
+		if pathParams["tick"] == "9999" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"latestTick": ` + strconv.Itoa(ticks.tick) + `,"tick":` + pathParams["tick"] + `}`))
+	}
+	require.NoError(t, mux.HandlePath(http.MethodGet, "/api/v1/ticks/{tick}/events/qu-transfers", handler))
+	require.NoError(t, mux.HandlePath(http.MethodGet, "/api/v1/entities/{identity}/events/qu-transfers", handler))
+	counter := &FakeCacheCounter{}
+	return NewResponseCache(capacity, 5*time.Second, counter).handler(mux, &runtime.JSONPb{}, auth, ticks), &calls, counter, ticks
+}
+
+func getCached(handler http.Handler, url string, headers ...string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodGet, url, nil)
+	for i := 0; i+1 < len(headers); i += 2 {
+		request.Header.Set(headers[i], headers[i+1])
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestResponseCache_givenTickResponse_thenServeFromCache(t *testing.T) {
+	handler, calls, counter := cachedMux(t, 10, nil)
+
+	first := getCached(handler, "/api/v1/ticks/1/events/qu-transfers")
+	assert.Equal(t, http.StatusOK, first.Code)
+	assert.Equal(t, `{"latestTick":100,"tick":1}`, first.Body.String())
+	assert.Equal(t, "public, max-age=5", first.Header().Get("Cache-Control"))
+	assert.NotEmpty(t, first.Header().Get("ETag"))
+
+	second := getCached(handler, "/api/v1/ticks/1/events/qu-transfers")
+	assert.Equal(t, `{"latestTick":100,"tick":1}`, second.Body.String())
+	assert.Equal(t, "application/json", second.Header().Get("Content-Type"))
+	assert.Equal(t, first.Header().Get("ETag"), second.Header().Get("ETag"))
+	assert.Equal(t, 1, *calls)
+	assert.Equal(t, 1, counter.counts[cacheResultHit])
+	assert.Equal(t, 1, counter.counts[cacheResultMiss])
+}
+
+func TestResponseCache_givenLatestTickAdvanced_thenServeCurrentLatestTick(t *testing.T) {
+	handler, calls, _, ticks := cachedMuxWithTicks(t, 10, nil)
+	first := getCached(handler, "/api/v1/ticks/1/events/qu-transfers")
+
+	ticks.tick = 101
+	second := getCached(handler, "/api/v1/ticks/1/events/qu-transfers", "If-None-Match", first.Header().Get("ETag"))
+	assert.Equal(t, http.StatusOK, second.Code)
+	assert.Equal(t, `{"latestTick":101,"tick":1}`, second.Body.String())
+	assert.NotEqual(t, first.Header().Get("ETag"), second.Header().Get("ETag"))
+	assert.Equal(t, 1, *calls)
+
+	third := getCached(handler, "/api/v1/ticks/1/events/qu-transfers", "If-None-Match", second.Header().Get("ETag"))
+	assert.Equal(t, http.StatusNotModified, third.Code)
+}
+
+func TestResponseCache_givenLatestTickError_thenServeFromMux(t *testing.T) {
+	handler, calls, counter, ticks := cachedMuxWithTicks(t, 10, nil)
+	getCached(handler, "/api/v1/ticks/1/events/qu-transfers")
+
+	ticks.err = errors.New("test")
+	response := getCached(handler, "/api/v1/ticks/1/events/qu-transfers")
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Empty(t, response.Header().Get("ETag"))
+	assert.Equal(t, 2, *calls)
+	assert.Equal(t, 0, counter.counts[cacheResultHit])
+}
+
+func TestResponseCache_givenIfNoneMatch_thenNotModified(t *testing.T) {
+	handler, _, _ := cachedMux(t, 10, nil)
+	etag := getCached(handler, "/api/v1/ticks/1/events/qu-transfers").Header().Get("ETag")
+
+	response := getCached(handler, "/api/v1/ticks/1/events/qu-transfers", "If-None-Match", `"other", W/`+etag)
+	assert.Equal(t, http.StatusNotModified, response.Code)
+	assert.Empty(t, response.Body.String())
+
+	response = getCached(handler, "/api/v1/ticks/1/events/qu-transfers", "If-None-Match", `"other"`)
+	assert.Equal(t, http.StatusOK, response.Code)
+}
+
+func TestResponseCache_givenError_thenDoNotCache(t *testing.T) {
+	handler, calls, _ := cachedMux(t, 10, nil)
+	assert.Equal(t, http.StatusNotFound, getCached(handler, "/api/v1/ticks/9999/events/qu-transfers").Code)
+	response := getCached(handler, "/api/v1/ticks/9999/events/qu-transfers")
+	assert.Equal(t, http.StatusNotFound, response.Code)
+	assert.Empty(t, response.Header().Get("Cache-Control"))
+	assert.Equal(t, 2, *calls)
+}
+
+//goland:noinspection SpellCheckingInspection
+func TestResponseCache_givenEntityResponse_thenShortMaxAgeAndNotCached(t *testing.T) {
+	handler, calls, _ := cachedMux(t, 10, nil)
+	url := "/api/v1/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/events/qu-transfers"
+	response := getCached(handler, url)
+	assert.Equal(t, "public, max-age=5", response.Header().Get("Cache-Control"))
+	assert.Equal(t, http.StatusNotModified, getCached(handler, url, "If-None-Match", response.Header().Get("ETag")).Code)
+	assert.Equal(t, 2, *calls)
+}
+
+func TestResponseCache_givenCapacityExceeded_thenEvictLeastRecentlyUsed(t *testing.T) {
+	handler, calls, _ := cachedMux(t, 2, nil)
+	getCached(handler, "/api/v1/ticks/1/events/qu-transfers")
+	getCached(handler, "/api/v1/ticks/2/events/qu-transfers")
+	getCached(handler, "/api/v1/ticks/1/events/qu-transfers") // hit
+	getCached(handler, "/api/v1/ticks/3/events/qu-transfers") // evicts 2
+	assert.Equal(t, 3, *calls)
+	getCached(handler, "/api/v1/ticks/1/events/qu-transfers") // hit
+	getCached(handler, "/api/v1/ticks/2/events/qu-transfers")
+	assert.Equal(t, 4, *calls)
+}
+
+func TestResponseCache_givenAuth_thenCheckKeyOnHit(t *testing.T) {
+	auth, _ := newTestAuthenticator(t, true, db.ApiKey{Name: "test", KeyHash: HashApiKey("qtr_key"), Enabled: true})
+	handler, _, _ := cachedMux(t, 10, auth)
+	response := getCached(handler, "/api/v1/ticks/1/events/qu-transfers", ApiKeyHeader, "qtr_key") // mux without interceptors
+	assert.Equal(t, "private, max-age=5", response.Header().Get("Cache-Control"))
+
+	assert.Equal(t, http.StatusOK, getCached(handler, "/api/v1/ticks/1/events/qu-transfers", ApiKeyHeader, "qtr_key").Code)
+	assert.Equal(t, http.StatusUnauthorized, getCached(handler, "/api/v1/ticks/1/events/qu-transfers").Code)
+}
+
+func Test_MatchesEtag(t *testing.T) {
+	assert.True(t, matchesEtag(`"abc"`, `"abc"`))
+	assert.True(t, matchesEtag(`W/"abc"`, `"abc"`))
+	assert.True(t, matchesEtag(`"x", "abc"`, `"abc"`))
+	assert.True(t, matchesEtag(`*`, `"abc"`))
+	assert.False(t, matchesEtag(``, `"abc"`))
+	assert.False(t, matchesEtag(`"abcd"`, `"abc"`))
+}
@@ -0,0 +1,147 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gookit/slog"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	RequestIdHeader    = "x-request-id"
+	maxRequestIdLength = 128
+)
+
+type RequestObserver interface {
+	ObserveApiRequest(method, code string, duration time.Duration)
+}
+
+type requestIdKey struct{}
+
+// RequestIdFromContext returns the id of the current request or an empty string, if there is none.
+func RequestIdFromContext(ctx context.Context) string {
+	requestId, _ := ctx.Value(requestIdKey{}).(string)
+	return requestId
+}
+
+// requestIdFromMetadata returns the request id of the caller, if it is usable, or a new one.
+func requestIdFromMetadata(ctx context.Context) string {
+	if values := metadata.ValueFromIncomingContext(ctx, RequestIdHeader); len(values) > 0 && isValidRequestId(values[0]) {
+		return values[0]
+	}
+	return uuid.New().String()
+}
+
+// isValidRequestId accepts printable ascii ids of limited length, so that the ids can be logged and returned safely.
+func isValidRequestId(requestId string) bool {
+	return len(requestId) > 0 && len(requestId) <= maxRequestIdLength && !strings.ContainsFunc(requestId, func(r rune) bool {
+		return r < '!' || r > '~'
+	})
+}
+
+// RequestInterceptor assigns request ids and logs and measures the grpc calls.
+type RequestInterceptor struct {
+	observer RequestObserver // optional
+}
+
+func NewRequestInterceptor(observer RequestObserver) *RequestInterceptor {
+	return &RequestInterceptor{observer: observer}
+}
+
+func (i *RequestInterceptor) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		ctx = i.withRequestId(ctx)
+		response, err := handler(ctx, req)
+		i.done(ctx, info.FullMethod, start, err)
+		return response, err
+	}
+}
+
+func (i *RequestInterceptor) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		ctx := i.withRequestId(ss.Context())
+		err := handler(srv, &requestStream{ServerStream: ss, ctx: ctx})
+		i.done(ctx, info.FullMethod, start, err)
+		return err
+	}
+}
+
+// withRequestId stores the request id in the context and returns it to the caller in the response headers.
+func (i *RequestInterceptor) withRequestId(ctx context.Context) context.Context {
+	requestId := requestIdFromMetadata(ctx)
+	if err := grpc.SetHeader(ctx, metadata.Pairs(RequestIdHeader, requestId)); err != nil {
+		slog.Warn("setting request id header", "error", err, "requestId", requestId)
+	}
+	return context.WithValue(ctx, requestIdKey{}, requestId)
+}
+
+func (i *RequestInterceptor) done(ctx context.Context, method string, start time.Time, err error) {
+	duration := time.Since(start)
+	code := status.Code(err)
+	if i.observer != nil {
+		i.observer.ObserveApiRequest(method, code.String(), duration)
+	}
+
+	args := []any{"Api request.", "method", method, "peer", peerAddress(ctx), "duration", duration, "status", code.String(), "requestId", RequestIdFromContext(ctx)}
+	if forwardedFor := metadata.ValueFromIncomingContext(ctx, "x-forwarded-for"); len(forwardedFor) > 0 {
+		args = append(args, "forwardedFor", forwardedFor[0])
+	}
+	switch code {
+	case codes.OK:
+		slog.Info(args...)
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		slog.Error(append(args, "error", status.Convert(err).Message())...)
+	default:
+		slog.Warn(append(args, "error", status.Convert(err).Message())...)
+	}
+}
+
+func peerAddress(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
+
+// requestStream replaces the stream context with the one, that contains the request id.
+type requestStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *requestStream) Context() context.Context {
+	return s.ctx
+}
+
+// requestIdHandler makes sure, that every http request has a request id, and returns it in the response headers. The
+// gateway forwards the id to the grpc server. Custom handlers find the id in the request context.
+func requestIdHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestId := r.Header.Get(RequestIdHeader)
+		if !isValidRequestId(requestId) {
+			requestId = uuid.New().String()
+			r.Header.Set(RequestIdHeader, requestId)
+		}
+		w.Header().Set(RequestIdHeader, requestId)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestIdKey{}, requestId)))
+	})
+}
+
+// outgoingHeaderMatcher returns the grpc response headers with the default prefix.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, RequestIdHeader) {
+		return "", false // already set by the request id handler
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"context"
+	"go-transfers/proto"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type FakeRequestObserver struct {
+	mutex        sync.Mutex
+	observations map[string]int
+}
+
+func (f *FakeRequestObserver) ObserveApiRequest(method, code string, _ time.Duration) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.observations == nil {
+		f.observations = map[string]int{}
+	}
+	f.observations[method+"/"+code]++
+}
+
+func TestRequestInterceptor_UnaryInterceptor_givenRequestId_thenPropagateAndObserve(t *testing.T) {
+	observer := &FakeRequestObserver{}
+	interceptor := NewRequestInterceptor(observer).UnaryInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/test/Method"}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIdHeader, "test-request-id"))
+	var requestId string
+	_, err := interceptor(ctx, nil, info, func(ctx context.Context, _ any) (any, error) {
+		requestId = RequestIdFromContext(ctx)
+		return nil, status.Error(codes.NotFound, "not found")
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, "test-request-id", requestId)
+	assert.Equal(t, 1, observer.observations["/test/Method/NotFound"])
+}
+
+func TestRequestInterceptor_UnaryInterceptor_givenInvalidRequestId_thenGenerate(t *testing.T) {
+	interceptor := NewRequestInterceptor(nil).UnaryInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/test/Method"}
+
+	for _, given := range []string{"", "with space", string(make([]byte, maxRequestIdLength+1))} {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIdHeader, given))
+		var requestId string
+		_, err := interceptor(ctx, nil, info, func(ctx context.Context, _ any) (any, error) {
+			requestId = RequestIdFromContext(ctx)
+			return nil, nil
+		})
+		require.NoError(t, err)
+		assert.Len(t, requestId, 36) // uuid
+	}
+}
+
+func TestServer_givenGrpcRequestId_thenReturnRequestIdHeader(t *testing.T) {
+	conn, err := grpc.NewClient("localhost:8081", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), RequestIdHeader, "grpc-request-id")
+	var header metadata.MD
+	_, err = proto.NewTransferServiceClient(conn).Health(ctx, &emptypb.Empty{}, grpc.Header(&header))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"grpc-request-id"}, header.Get(RequestIdHeader))
+}
+
+func TestServer_givenHttpRequestId_thenReturnRequestIdHeader(t *testing.T) {
+	request, err := http.NewRequest(http.MethodGet, "http://localhost:8080/api/v1/ticks/1234/events/qu-transfers", nil)
+	require.NoError(t, err)
+	request.Header.Set(RequestIdHeader, "http-request-id")
+
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, []string{"http-request-id"}, response.Header.Values(RequestIdHeader))
+	assert.Empty(t, response.Header.Values("Grpc-Metadata-X-Request-Id"))
+}
+
+func TestServer_givenNoHttpRequestId_thenReturnNewRequestId(t *testing.T) {
+	for _, url := range []string{"http://localhost:8080/api/v2/ticks/12345/transfers", "http://localhost:8080/status/live"} {
+		response, err := http.Get(url)
+		require.NoError(t, err)
+		_ = response.Body.Close()
+		assert.Len(t, response.Header.Get(RequestIdHeader), 36, url)
+	}
+}
+
+func TestServer_givenHttpRequestId_whenError_thenReturnRequestIdInBody(t *testing.T) {
+	for _, url := range []string{"http://localhost:8080/api/v1/entities/BLAH/balance-history", "http://localhost:8080/api/v1/entities/BLAH/export"} {
+		request, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		request.Header.Set(RequestIdHeader, "http-error-id")
+
+		response, err := http.DefaultClient.Do(request)
+		require.NoError(t, err)
+		body, err := readBody(response.Body)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode, url)
+		assert.Equal(t, "http-error-id", response.Header.Get(RequestIdHeader), url)
+		assert.Contains(t, string(body), `"requestId":"http-error-id"`, url)
+	}
+}
@@ -1,18 +1,21 @@
 package api
 
 import (
+	"crypto/tls"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"net/http"
 	"time"
 )
 
 type MetricsServer struct {
-	address string
+	address   string
+	tlsConfig *tls.Config // optional
 }
 
-func NewMetricsServer(address string) *MetricsServer {
+func NewMetricsServer(address string, tlsConfig *tls.Config) *MetricsServer {
 	server := &MetricsServer{
-		address: address,
+		address:   address,
+		tlsConfig: tlsConfig,
 	}
 	return server
 }
@@ -29,9 +32,16 @@ func (s *MetricsServer) Start() {
 			ReadTimeout:       15 * time.Second,
 			ReadHeaderTimeout: 15 * time.Second,
 			WriteTimeout:      15 * time.Second,
+			TLSConfig:         s.tlsConfig,
 		}
 
-		if err := server.ListenAndServe(); err != nil {
+		var err error
+		if s.tlsConfig != nil {
+			err = server.ListenAndServeTLS("", "") // certificates from tls config
+		} else {
+			err = server.ListenAndServe()
+		}
+		if err != nil {
 			panic(err)
 		}
 	}()
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"encoding/base64"
+	"fmt"
+	"go-transfers/db"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+type pageable interface {
+	GetTick() uint32
+	GetEventId() uint64
+}
+
+// pageSize returns the requested page size bounded to the maximum or the default, if none is requested.
+func pageSize(requested uint32) int {
+	switch {
+	case requested == 0:
+		return defaultPageSize
+	case requested > maxPageSize:
+		return maxPageSize
+	default:
+		return int(requested)
+	}
+}
+
+// nextPage truncates the events to the page size and returns a token for the next page, if there are more events.
+// The events need to be queried with a limit of one more than the page size.
+func nextPage[T pageable](events []T, size int) ([]T, string) {
+	if len(events) <= size {
+		return events, ""
+	}
+	events = events[:size]
+	last := events[size-1]
+	return events, encodePageToken(db.Cursor{Tick: last.GetTick(), EventId: last.GetEventId()})
+}
+
+func encodePageToken(cursor db.Cursor) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d", cursor.Tick, cursor.EventId)))
+}
+
+func decodePageToken(token string) (*db.Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding page token")
+	}
+	var cursor db.Cursor
+	_, err = fmt.Sscanf(string(decoded), "%d:%d", &cursor.Tick, &cursor.EventId)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing page token")
+	}
+	return &cursor, nil
+}
+
+// mergePage merges the events of two types, that are ordered ascending by tick and event id, into one page of the
+// given size and returns a token for the next page, if there are more events. Both event lists need to be queried
+// with a limit of one more than the page size.
+func mergePage[A, B pageable](first []A, second []B, size int) ([]A, []B, string) {
+	var i, j int
+	var last pageable
+	for i+j < size && (i < len(first) || j < len(second)) {
+		if j == len(second) || (i < len(first) && before(first[i], second[j])) {
+			last = first[i]
+			i++
+		} else {
+			last = second[j]
+			j++
+		}
+	}
+	if i == len(first) && j == len(second) {
+		return first, second, ""
+	}
+	return first[:i], second[:j], encodePageToken(db.Cursor{Tick: last.GetTick(), EventId: last.GetEventId()})
+}
+
+func before(a, b pageable) bool {
+	return a.GetTick() < b.GetTick() || (a.GetTick() == b.GetTick() && a.GetEventId() < b.GetEventId())
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"go-transfers/db"
+	"go-transfers/proto"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaging_PageToken_RoundTrip(t *testing.T) {
+	token := encodePageToken(db.Cursor{Tick: 12345678, EventId: 987654321})
+	cursor, err := decodePageToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, &db.Cursor{Tick: 12345678, EventId: 987654321}, cursor)
+}
+
+func TestPaging_DecodePageToken_GivenEmpty_ThenNoCursor(t *testing.T) {
+	cursor, err := decodePageToken("")
+	assert.NoError(t, err)
+	assert.Nil(t, cursor)
+}
+
+func TestPaging_DecodePageToken_GivenInvalid_ThenError(t *testing.T) {
+	_, err := decodePageToken("not a token")
+	assert.Error(t, err)
+	_, err = decodePageToken("Zm9v") // foo
+	assert.Error(t, err)
+}
+
+func TestPaging_PageSize(t *testing.T) {
+	assert.Equal(t, defaultPageSize, pageSize(0))
+	assert.Equal(t, 10, pageSize(10))
+	assert.Equal(t, maxPageSize, pageSize(maxPageSize+1))
+}
+
+func TestPaging_NextPage(t *testing.T) {
+	events := []*proto.QuTransferEvent{
+		{Tick: 3, EventId: 30},
+		{Tick: 2, EventId: 20},
+		{Tick: 1, EventId: 10},
+	}
+
+	page, token := nextPage(events, 3)
+	assert.Len(t, page, 3)
+	assert.Empty(t, token)
+
+	page, token = nextPage(events, 2)
+	assert.Len(t, page, 2)
+	cursor, err := decodePageToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, &db.Cursor{Tick: 2, EventId: 20}, cursor)
+}
+
+func TestPaging_MergePage(t *testing.T) {
+	quTransfers := []*proto.QuTransferEvent{
+		{Tick: 1, EventId: 10},
+		{Tick: 2, EventId: 22},
+		{Tick: 3, EventId: 30},
+	}
+	assetChanges := []*proto.AssetChangeEvent{
+		{Tick: 2, EventId: 21},
+		{Tick: 4, EventId: 40},
+	}
+
+	first, second, token := mergePage(quTransfers, assetChanges, 5)
+	assert.Len(t, first, 3)
+	assert.Len(t, second, 2)
+	assert.Empty(t, token)
+
+	first, second, token = mergePage(quTransfers, assetChanges, 3)
+	assert.Equal(t, quTransfers[:2], first)
+	assert.Equal(t, assetChanges[:1], second)
+	cursor, err := decodePageToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, &db.Cursor{Tick: 2, EventId: 22}, cursor)
+}
@@ -2,84 +2,118 @@ package api
 
 import (
 	"context"
+	"crypto/tls"
+	"go-transfers/db"
 	"go-transfers/proto"
+	"go-transfers/proto/v2"
 	"net"
 	"net/http"
-	"strconv"
 	"strings"
 
-	"github.com/google/uuid"
 	"github.com/gookit/slog"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/pkg/errors"
 	"github.com/qubic/go-qubic/common"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
-	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+const (
+	maxBatchIdentities = 100
+	maxBatchTickRange  = 10000
+	maxTickRange       = 1000
+	gatewayTarget      = "passthrough:///gateway"
+	gatewayBufferSize  = 1024 * 1024
+	gatewayNetwork     = "bufconn" // network of the in memory gateway connections
+)
+
 type Server struct {
 	proto.UnimplementedTransferServiceServer
 	listenAddrGRPC string
 	listenAddrHTTP string
 	repository     Repository
+	ticks          TickSource
+	auth           *KeyAuthenticator // optional
+	cache          *ResponseCache    // optional
+	interceptor    *RequestInterceptor
+	health         *HealthChecker
+	tlsConfig      *tls.Config // optional
 }
 
 type Repository interface {
 	GetLatestTick(ctx context.Context) (int, error)
+	GetEpochStartTick(ctx context.Context) (int, error)
 	GetAssetChangeEventsForTick(ctx context.Context, tickNumber int) ([]*proto.AssetChangeEvent, error)
 	GetQuTransferEventsForTick(ctx context.Context, tickNumber int) ([]*proto.QuTransferEvent, error)
-	GetQuTransferEventsForEntity(ctx context.Context, identity string) ([]*proto.QuTransferEvent, error)
-	GetAssetChangeEventsForEntity(ctx context.Context, identity string) ([]*proto.AssetChangeEvent, error)
+	GetQuTransferEventsForEntity(ctx context.Context, identity string, filter db.EntityFilter) ([]*proto.QuTransferEvent, error)
+	GetAssetChangeEventsForEntity(ctx context.Context, identity string, filter db.EntityFilter) ([]*proto.AssetChangeEvent, error)
+	CountQuTransferEventsForEntity(ctx context.Context, identity string, filter db.EntityFilter) (int, error)
+	CountAssetChangeEventsForEntity(ctx context.Context, identity string, filter db.EntityFilter) (int, error)
+	GetQuTransferEventsForTransaction(ctx context.Context, hash string) ([]*proto.QuTransferEvent, error)
+	GetAssetChangeEventsForTransaction(ctx context.Context, hash string) ([]*proto.AssetChangeEvent, error)
+	GetQuBalanceHistoryForEntity(ctx context.Context, identity string, filter db.EntityFilter) ([]*proto.QuBalanceChange, error)
+	GetAssetHoldingsForEntity(ctx context.Context, identity string) ([]*proto.AssetHolding, error)
+	GetAssetHolders(ctx context.Context, issuer, name string) ([]*proto.AssetHolder, error)
+	GetAssets(ctx context.Context) ([]*proto.Asset, error)
+	GetQuTransferEventsForTickRange(ctx context.Context, fromTick, toTick uint32, filter db.TransferFilter) ([]*proto.QuTransferEvent, error)
+	GetAssetChangeEventsForTickRange(ctx context.Context, fromTick, toTick uint32, filter db.TransferFilter) ([]*proto.AssetChangeEvent, error)
+	GetQuTransferEventsForEntities(ctx context.Context, identities []string, fromTick, toTick uint32) ([]*db.EntityQuTransferEvent, error)
+	GetAssetChangeEventsForEntities(ctx context.Context, identities []string, fromTick, toTick uint32) ([]*db.EntityAssetChangeEvent, error)
+	GetCounterpartiesForEntity(ctx context.Context, identity string, filter db.EntityFilter) ([]db.CounterpartyVolume, error)
+	GetTransferEdges(ctx context.Context, identities []string, backward bool, filter db.EntityFilter, minAmount uint64, fanOut int) ([]db.TransferEdge, error)
+	ExportEventsForEntity(ctx context.Context, identity string, fromTick, toTick uint32, handle func(row *db.ExportRow) error) error
+	GetAssetChangeEventsForAsset(ctx context.Context, issuer, name string, filter db.EntityFilter) ([]*proto.AssetChangeEvent, error)
+	CountAssetChangeEventsForAsset(ctx context.Context, issuer, name string, filter db.EntityFilter) (int, error)
+	GetTransferEventsForTick(ctx context.Context, tickNumber uint32) ([]*db.TransferEvent, error)
+	GetTransferEventsForTransaction(ctx context.Context, hash string) ([]*db.TransferEvent, error)
+	GetTransferEventsForEntity(ctx context.Context, identity string, eventTypes []uint32, filter db.EntityFilter) ([]*db.TransferEvent, error)
 }
 
-func NewServer(grpcAdders, httpAddress string, repository Repository) *Server {
+// NewServer creates the api server. If auth is nil, api keys are not checked. If cache is nil, http responses are not
+// cached. If healthChecker is nil, only the database is checked for readiness. If tlsConfig is nil, the server listens
+// in plaintext.
+func NewServer(grpcAdders, httpAddress string, repository Repository, ticks TickSource, auth *KeyAuthenticator, cache *ResponseCache, observer RequestObserver, healthChecker *HealthChecker, tlsConfig *tls.Config) *Server {
+	if healthChecker == nil {
+		healthChecker = NewHealthChecker(repository, nil, 0)
+	}
 
 	return &Server{
 		listenAddrGRPC: grpcAdders,
 		listenAddrHTTP: httpAddress,
 		repository:     repository,
+		ticks:          ticks,
+		auth:           auth,
+		cache:          cache,
+		interceptor:    NewRequestInterceptor(observer),
+		health:         healthChecker,
+		tlsConfig:      tlsConfig,
 	}
 
 }
 
 func (s *Server) Health(ctx context.Context, _ *emptypb.Empty) (*proto.HealthResponse, error) {
-	serviceStatus := "UP"
-	tickNumber, err := s.repository.GetLatestTick(ctx)
-	dbStatus := "UP"
-	if err != nil {
-		serviceStatus = "ERROR"
-		dbStatus = "ERROR"
-	}
-	return &proto.HealthResponse{
-		Status: serviceStatus,
-		Components: map[string]*proto.Component{
-			"db": {
-				Status: dbStatus,
-				Details: map[string]string{
-					"latestTick": strconv.Itoa(tickNumber),
-				},
-			},
-		},
-	}, nil
+	return s.health.check(ctx), nil
 }
 
 func (s *Server) GetAssetEventsForTick(ctx context.Context, request *proto.TickRequest) (*proto.AssetEventsResponse, error) {
 	tickNumber := request.GetTick()
 	latestTick, err := s.repository.GetLatestTick(ctx)
 	if err != nil {
-		return nil, retrieveEventsError("getting latest tick.", "error", err)
+		return nil, retrieveEventsError(ctx, "getting latest tick.", "error", err)
 	}
 	if latestTick < int(tickNumber) {
-		return nil, tickNotFound(tickNumber, latestTick)
+		return nil, s.tickNotFound(ctx, tickNumber, latestTick)
 	}
 	slog.Debug("Get asset events:", "tick", tickNumber, "latest", latestTick)
 	assetChangeEvents, err := s.repository.GetAssetChangeEventsForTick(ctx, int(tickNumber))
 	if err != nil {
-		return nil, retrieveEventsError("getting asset change events.", "tickNumber", tickNumber, "error", err)
+		return nil, retrieveEventsError(ctx, "getting asset change events.", "tickNumber", tickNumber, "error", err)
 	}
 	response := proto.AssetEventsResponse{
 		LatestTick:   uint32(latestTick),
@@ -92,15 +126,15 @@ func (s *Server) GetAssetChangeEventsForTick(ctx context.Context, request *proto
 	tickNumber := request.GetTick()
 	latestTick, err := s.repository.GetLatestTick(ctx)
 	if err != nil {
-		return nil, retrieveEventsError("getting latest tick.", "error", err)
+		return nil, retrieveEventsError(ctx, "getting latest tick.", "error", err)
 	}
 	if latestTick < int(tickNumber) {
-		return nil, tickNotFound(tickNumber, latestTick)
+		return nil, s.tickNotFound(ctx, tickNumber, latestTick)
 	}
 	slog.Debug("Get asset transfers:", "tick", tickNumber, "latest", latestTick)
 	events, err := s.repository.GetAssetChangeEventsForTick(ctx, int(tickNumber))
 	if err != nil {
-		return nil, retrieveEventsError("getting asset change events.", "tickNumber", tickNumber, "error", err)
+		return nil, retrieveEventsError(ctx, "getting asset change events.", "tickNumber", tickNumber, "error", err)
 	}
 	response := proto.AssetChangeEventsResponse{LatestTick: uint32(latestTick), Events: events}
 	return &response, nil
@@ -110,15 +144,15 @@ func (s *Server) GetQuTransferEventsForTick(ctx context.Context, request *proto.
 	tickNumber := request.GetTick()
 	latestTick, err := s.repository.GetLatestTick(ctx)
 	if err != nil {
-		return nil, retrieveEventsError("getting latest tick.", "error", err)
+		return nil, retrieveEventsError(ctx, "getting latest tick.", "error", err)
 	}
 	if latestTick < int(tickNumber) {
-		return nil, tickNotFound(tickNumber, latestTick)
+		return nil, s.tickNotFound(ctx, tickNumber, latestTick)
 	}
 	slog.Debug("Get qu transfers:", "tick", tickNumber, "latest", latestTick)
 	events, err := s.repository.GetQuTransferEventsForTick(ctx, int(tickNumber))
 	if err != nil {
-		return nil, retrieveEventsError("getting qu transfer events", "tickNumber", tickNumber, "error", err)
+		return nil, retrieveEventsError(ctx, "getting qu transfer events", "tickNumber", tickNumber, "error", err)
 	}
 
 	response := proto.QuTransferEventsResponse{LatestTick: uint32(latestTick), Events: events}
@@ -128,43 +162,413 @@ func (s *Server) GetQuTransferEventsForTick(ctx context.Context, request *proto.
 func (s *Server) GetAssetChangeEventsForEntity(ctx context.Context, request *proto.EntityRequest) (*proto.AssetChangeEventsResponse, error) {
 	identity := request.GetIdentity()
 	if !isValidIdentity(identity) {
-		return nil, invalidIdentity(identity)
+		return nil, invalidIdentity(ctx, "identity", identity)
+	}
+	filter, err := entityFilter(ctx, request)
+	if err != nil {
+		return nil, err
 	}
 	latestTick, err := s.repository.GetLatestTick(ctx)
 	if err != nil {
-		return nil, retrieveEventsError("getting latest tick.", "error", err)
+		return nil, retrieveEventsError(ctx, "getting latest tick.", "error", err)
 	}
 	slog.Debug("Get asset transfers:", "entity", identity, "latest", latestTick)
 
-	events, err := s.repository.GetAssetChangeEventsForEntity(ctx, identity)
+	events, err := s.repository.GetAssetChangeEventsForEntity(ctx, identity, filter)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting asset change events", "identity", identity, "error", err)
+	}
+	count, err := s.repository.CountAssetChangeEventsForEntity(ctx, identity, filter)
 	if err != nil {
-		return nil, retrieveEventsError("getting asset change events", "identity", identity, "error", err)
+		return nil, retrieveEventsError(ctx, "counting asset change events", "identity", identity, "error", err)
 	}
+	events, nextPageToken := nextPage(events, filter.Limit-1)
 
-	response := proto.AssetChangeEventsResponse{LatestTick: uint32(latestTick), Events: events}
+	response := proto.AssetChangeEventsResponse{
+		LatestTick:    uint32(latestTick),
+		Events:        events,
+		NextPageToken: nextPageToken,
+		TotalCount:    uint64(count),
+	}
 	return &response, nil
 }
 
 func (s *Server) GetQuTransferEventsForEntity(ctx context.Context, request *proto.EntityRequest) (*proto.QuTransferEventsResponse, error) {
 	identity := request.GetIdentity()
 	if !isValidIdentity(identity) {
-		return nil, invalidIdentity(identity)
+		return nil, invalidIdentity(ctx, "identity", identity)
+	}
+	filter, err := entityFilter(ctx, request)
+	if err != nil {
+		return nil, err
 	}
 	latestTick, err := s.repository.GetLatestTick(ctx)
 	if err != nil {
-		return nil, retrieveEventsError("getting latest tick.", "error", err)
+		return nil, retrieveEventsError(ctx, "getting latest tick.", "error", err)
 	}
 	slog.Debug("Get qu transfers", "entity", identity, "latest", latestTick)
 
-	events, err := s.repository.GetQuTransferEventsForEntity(ctx, identity)
+	events, err := s.repository.GetQuTransferEventsForEntity(ctx, identity, filter)
 	if err != nil {
-		return nil, retrieveEventsError("getting qu transfer events", "identity", identity, "error", err)
+		return nil, retrieveEventsError(ctx, "getting qu transfer events", "identity", identity, "error", err)
 	}
+	count, err := s.repository.CountQuTransferEventsForEntity(ctx, identity, filter)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "counting qu transfer events", "identity", identity, "error", err)
+	}
+	events, nextPageToken := nextPage(events, filter.Limit-1)
 
-	response := proto.QuTransferEventsResponse{LatestTick: uint32(latestTick), Events: events}
+	response := proto.QuTransferEventsResponse{
+		LatestTick:    uint32(latestTick),
+		Events:        events,
+		NextPageToken: nextPageToken,
+		TotalCount:    uint64(count),
+	}
+	return &response, nil
+}
+
+func (s *Server) GetTransfersForTransaction(ctx context.Context, request *proto.TransactionRequest) (*proto.TransactionEventsResponse, error) {
+	hash := request.GetHash()
+	if !isValidTransactionHash(hash) {
+		return nil, invalidArgument(ctx, "hash", errors.New("invalid transaction hash"))
+	}
+	latestTick, err := s.repository.GetLatestTick(ctx)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting latest tick.", "error", err)
+	}
+	slog.Debug("Get transaction events", "hash", hash, "latest", latestTick)
+
+	quTransfers, err := s.repository.GetQuTransferEventsForTransaction(ctx, hash)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting qu transfer events", "hash", hash, "error", err)
+	}
+	assetChanges, err := s.repository.GetAssetChangeEventsForTransaction(ctx, hash)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting asset change events", "hash", hash, "error", err)
+	}
+
+	response := proto.TransactionEventsResponse{
+		LatestTick:   uint32(latestTick),
+		QuTransfers:  quTransfers,
+		AssetChanges: assetChanges,
+	}
 	return &response, nil
 }
 
+func (s *Server) GetQuBalanceHistoryForEntity(ctx context.Context, request *proto.EntityRequest) (*proto.QuBalanceHistoryResponse, error) {
+	identity := request.GetIdentity()
+	if !isValidIdentity(identity) {
+		return nil, invalidIdentity(ctx, "identity", identity)
+	}
+	filter, err := entityFilter(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	latestTick, err := s.repository.GetLatestTick(ctx)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting latest tick.", "error", err)
+	}
+	slog.Debug("Get qu balance history", "entity", identity, "latest", latestTick)
+
+	changes, err := s.repository.GetQuBalanceHistoryForEntity(ctx, identity, filter)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting qu balance history", "identity", identity, "error", err)
+	}
+	// changes are summed per tick. the tick is enough for the cursor.
+	var nextPageToken string
+	if size := filter.Limit - 1; len(changes) > size {
+		changes = changes[:size]
+		nextPageToken = encodePageToken(db.Cursor{Tick: changes[size-1].GetTick()})
+	}
+
+	response := proto.QuBalanceHistoryResponse{
+		LatestTick:           uint32(latestTick),
+		AaaTransfersExcluded: true, // AAA transfers are filtered out on sync
+		Changes:              changes,
+		NextPageToken:        nextPageToken,
+	}
+	return &response, nil
+}
+
+func (s *Server) GetCounterpartiesForEntity(ctx context.Context, request *proto.CounterpartiesRequest) (*proto.CounterpartiesResponse, error) {
+	identity := request.GetIdentity()
+	if !isValidIdentity(identity) {
+		return nil, invalidIdentity(ctx, "identity", identity)
+	}
+	fromTick, toTick := request.GetFromTick(), request.GetToTick()
+	if fromTick > 0 && toTick > 0 && fromTick > toTick {
+		return nil, invalidArgument(ctx, "to_tick", errors.New("must not be before from_tick"))
+	}
+	latestTick, err := s.repository.GetLatestTick(ctx)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting latest tick.", "error", err)
+	}
+	slog.Debug("Get counterparties", "entity", identity, "latest", latestTick)
+
+	filter := db.EntityFilter{FromTick: fromTick, ToTick: toTick, Limit: pageSize(request.GetLimit())}
+	volumes, err := s.repository.GetCounterpartiesForEntity(ctx, identity, filter)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting counterparties", "identity", identity, "error", err)
+	}
+
+	response := proto.CounterpartiesResponse{LatestTick: uint32(latestTick)}
+	var counterparty *proto.Counterparty
+	for _, volume := range volumes { // grouped by counterparty
+		if counterparty == nil || counterparty.Identity != volume.Counterparty {
+			counterparty = &proto.Counterparty{Identity: volume.Counterparty, FirstTick: volume.FirstTick}
+			response.Counterparties = append(response.Counterparties, counterparty)
+		}
+		counterparty.TransferCount += uint64(volume.TransferCount)
+		counterparty.FirstTick = min(counterparty.FirstTick, volume.FirstTick)
+		counterparty.LastTick = max(counterparty.LastTick, volume.LastTick)
+		counterparty.Volumes = append(counterparty.Volumes, &proto.CounterpartyVolume{
+			IssuerId:       volume.Issuer,
+			Name:           volume.Name,
+			TransferCount:  uint64(volume.TransferCount),
+			IncomingAmount: volume.Incoming,
+			OutgoingAmount: volume.Outgoing,
+		})
+	}
+	return &response, nil
+}
+
+func (s *Server) GetAssetHoldingsForEntity(ctx context.Context, request *proto.HoldingsRequest) (*proto.AssetHoldingsResponse, error) {
+	identity := request.GetIdentity()
+	if !isValidIdentity(identity) {
+		return nil, invalidIdentity(ctx, "identity", identity)
+	}
+	latestTick, err := s.repository.GetLatestTick(ctx)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting latest tick.", "error", err)
+	}
+	slog.Debug("Get asset holdings", "entity", identity, "latest", latestTick)
+
+	holdings, err := s.repository.GetAssetHoldingsForEntity(ctx, identity)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting asset holdings", "identity", identity, "error", err)
+	}
+
+	response := proto.AssetHoldingsResponse{LatestTick: uint32(latestTick), Holdings: holdings}
+	return &response, nil
+}
+
+func (s *Server) GetAssetHolders(ctx context.Context, request *proto.AssetRequest) (*proto.AssetHoldersResponse, error) {
+	issuer := request.GetIssuer()
+	if !isValidIdentity(issuer) {
+		return nil, invalidIdentity(ctx, "issuer", issuer)
+	}
+	name := request.GetName()
+	if !isValidAssetName(name) {
+		return nil, invalidArgument(ctx, "name", errors.New("invalid asset name"))
+	}
+	latestTick, err := s.repository.GetLatestTick(ctx)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting latest tick.", "error", err)
+	}
+	slog.Debug("Get asset holders", "issuer", issuer, "name", name, "latest", latestTick)
+
+	holders, err := s.repository.GetAssetHolders(ctx, issuer, name)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting asset holders", "issuer", issuer, "name", name, "error", err)
+	}
+
+	response := proto.AssetHoldersResponse{LatestTick: uint32(latestTick), Holders: holders}
+	return &response, nil
+}
+
+func (s *Server) GetAssets(ctx context.Context, _ *emptypb.Empty) (*proto.AssetsResponse, error) {
+	latestTick, err := s.repository.GetLatestTick(ctx)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting latest tick.", "error", err)
+	}
+	slog.Debug("Get assets", "latest", latestTick)
+
+	assets, err := s.repository.GetAssets(ctx)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting assets", "error", err)
+	}
+
+	response := proto.AssetsResponse{LatestTick: uint32(latestTick), Assets: assets}
+	return &response, nil
+}
+
+func (s *Server) GetAssetChangeEventsForAsset(ctx context.Context, request *proto.AssetEventsRequest) (*proto.AssetChangeEventsResponse, error) {
+	issuer := request.GetIssuer()
+	if !isValidIdentity(issuer) {
+		return nil, invalidIdentity(ctx, "issuer", issuer)
+	}
+	name := request.GetName()
+	if !isValidAssetName(name) {
+		return nil, invalidArgument(ctx, "name", errors.New("invalid asset name"))
+	}
+	var filter db.EntityFilter
+	err := pagedTickFilter(ctx, &filter, request.GetFromTick(), request.GetToTick(), request.GetPageSize(), request.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	latestTick, err := s.repository.GetLatestTick(ctx)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting latest tick.", "error", err)
+	}
+	slog.Debug("Get asset transfers", "issuer", issuer, "name", name, "latest", latestTick)
+
+	events, err := s.repository.GetAssetChangeEventsForAsset(ctx, issuer, name, filter)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting asset change events", "issuer", issuer, "name", name, "error", err)
+	}
+	count, err := s.repository.CountAssetChangeEventsForAsset(ctx, issuer, name, filter)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "counting asset change events", "issuer", issuer, "name", name, "error", err)
+	}
+	events, nextPageToken := nextPage(events, filter.Limit-1)
+
+	response := proto.AssetChangeEventsResponse{
+		LatestTick:    uint32(latestTick),
+		Events:        events,
+		NextPageToken: nextPageToken,
+		TotalCount:    uint64(count),
+	}
+	return &response, nil
+}
+
+// GetEventsForTickRange returns the qu transfers and asset changes of a limited tick range in event order, so that
+// consumers don't need to request every tick separately.
+func (s *Server) GetEventsForTickRange(ctx context.Context, request *proto.TickRangeRequest) (*proto.TickRangeEventsResponse, error) {
+	fromTick, toTick := request.GetFromTick(), request.GetToTick()
+	if fromTick > toTick {
+		return nil, invalidArgument(ctx, "to_tick", errors.New("must not be before from_tick"))
+	}
+	if toTick-fromTick >= maxTickRange {
+		return nil, invalidArgument(ctx, "to_tick", errors.Errorf("tick range exceeds [%d] ticks", maxTickRange))
+	}
+	cursor, err := decodePageToken(request.GetPageToken())
+	if err != nil {
+		return nil, invalidArgument(ctx, "page_token", err)
+	}
+	latestTick, err := s.repository.GetLatestTick(ctx)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting latest tick.", "error", err)
+	}
+	if latestTick < int(toTick) {
+		return nil, s.tickNotFound(ctx, toTick, latestTick)
+	}
+	slog.Debug("Get events for tick range", "from", fromTick, "to", toTick, "latest", latestTick)
+
+	size := pageSize(request.GetPageSize())
+	filter := db.TransferFilter{After: cursor, Limit: size + 1} // one more to know if there is a next page
+	quTransfers, err := s.repository.GetQuTransferEventsForTickRange(ctx, fromTick, toTick, filter)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting qu transfer events", "from", fromTick, "to", toTick, "error", err)
+	}
+	assetChanges, err := s.repository.GetAssetChangeEventsForTickRange(ctx, fromTick, toTick, filter)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting asset change events", "from", fromTick, "to", toTick, "error", err)
+	}
+	quTransfers, assetChanges, nextPageToken := mergePage(quTransfers, assetChanges, size)
+
+	response := proto.TickRangeEventsResponse{
+		LatestTick:    uint32(latestTick),
+		QuTransfers:   quTransfers,
+		AssetChanges:  assetChanges,
+		NextPageToken: nextPageToken,
+	}
+	return &response, nil
+}
+
+// GetTransfersForEntities returns the transfers of many entities within a limited tick range with one query per event
+// type instead of one request per entity.
+func (s *Server) GetTransfersForEntities(ctx context.Context, request *proto.EntitiesRequest) (*proto.EntitiesTransfersResponse, error) {
+	identities := request.GetIdentities()
+	if len(identities) == 0 || len(identities) > maxBatchIdentities {
+		return nil, invalidArgument(ctx, "identities", errors.Errorf("expected 1 to %d identities", maxBatchIdentities))
+	}
+	for _, identity := range identities {
+		if !isValidIdentity(identity) {
+			return nil, invalidIdentity(ctx, "identities", identity)
+		}
+	}
+	fromTick, toTick := request.GetFromTick(), request.GetToTick()
+	if fromTick > toTick {
+		return nil, invalidArgument(ctx, "to_tick", errors.New("must not be before from_tick"))
+	}
+	if toTick-fromTick >= maxBatchTickRange {
+		return nil, invalidArgument(ctx, "to_tick", errors.Errorf("tick range exceeds [%d] ticks", maxBatchTickRange))
+	}
+	latestTick, err := s.repository.GetLatestTick(ctx)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting latest tick.", "error", err)
+	}
+	slog.Debug("Get transfers for entities", "count", len(identities), "from", fromTick, "to", toTick, "latest", latestTick)
+
+	quTransfers, err := s.repository.GetQuTransferEventsForEntities(ctx, identities, fromTick, toTick)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting qu transfer events", "count", len(identities), "error", err)
+	}
+	assetChanges, err := s.repository.GetAssetChangeEventsForEntities(ctx, identities, fromTick, toTick)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting asset change events", "count", len(identities), "error", err)
+	}
+
+	byIdentity := make(map[string]*proto.EntityTransfers, len(identities))
+	response := proto.EntitiesTransfersResponse{LatestTick: uint32(latestTick)}
+	for _, identity := range identities {
+		if _, ok := byIdentity[identity]; !ok { // ignore duplicates
+			byIdentity[identity] = &proto.EntityTransfers{Identity: identity}
+			response.Entities = append(response.Entities, byIdentity[identity])
+		}
+	}
+	for _, event := range quTransfers {
+		entity := byIdentity[event.Identity]
+		entity.QuTransfers = append(entity.QuTransfers, event.QuTransferEvent)
+	}
+	for _, event := range assetChanges {
+		entity := byIdentity[event.Identity]
+		entity.AssetChanges = append(entity.AssetChanges, event.AssetChangeEvent)
+	}
+	return &response, nil
+}
+
+func entityFilter(ctx context.Context, request *proto.EntityRequest) (db.EntityFilter, error) {
+	var filter db.EntityFilter
+	if request.GetFromTime() != nil {
+		if err := request.GetFromTime().CheckValid(); err != nil {
+			return filter, invalidArgument(ctx, "from_time", err)
+		}
+		filter.FromTime = request.GetFromTime().AsTime()
+	}
+	if request.GetToTime() != nil {
+		if err := request.GetToTime().CheckValid(); err != nil {
+			return filter, invalidArgument(ctx, "to_time", err)
+		}
+		filter.ToTime = request.GetToTime().AsTime()
+	}
+	if !filter.FromTime.IsZero() && !filter.ToTime.IsZero() && !filter.FromTime.Before(filter.ToTime) {
+		return filter, invalidArgument(ctx, "to_time", errors.New("must be after from_time"))
+	}
+	if _, ok := proto.Direction_name[int32(request.GetDirection())]; !ok {
+		return filter, invalidArgument(ctx, "direction", errors.Errorf("unknown direction [%d]", request.GetDirection()))
+	}
+	filter.Direction = request.GetDirection()
+	err := pagedTickFilter(ctx, &filter, request.GetFromTick(), request.GetToTick(), request.GetPageSize(), request.GetPageToken())
+	return filter, err
+}
+
+// pagedTickFilter validates and sets the tick range and the paging parameters of the filter.
+func pagedTickFilter(ctx context.Context, filter *db.EntityFilter, fromTick, toTick, size uint32, token string) error {
+	if fromTick > 0 && toTick > 0 && fromTick > toTick {
+		return invalidArgument(ctx, "to_tick", errors.New("must not be before from_tick"))
+	}
+	filter.FromTick = fromTick
+	filter.ToTick = toTick
+	cursor, err := decodePageToken(token)
+	if err != nil {
+		return invalidArgument(ctx, "page_token", err)
+	}
+	filter.After = cursor
+	filter.Limit = pageSize(size) + 1 // one more to know if there is a next page
+	return nil
+}
+
 func isValidIdentity(s string) bool {
 	if len(s) == 60 && !strings.ContainsFunc(s, func(r rune) bool {
 		return r < 'A' || r > 'Z'
@@ -180,36 +584,63 @@ func isValidIdentity(s string) bool {
 	return false
 }
 
-func invalidIdentity(id string) error {
-	errorId := uuid.New().String()
-	slog.Error("invalid request", "identity", id, "uuid", errorId)
-	return status.Errorf(codes.InvalidArgument, "invalid identity [%s]", errorId)
-}
-
-func tickNotFound(requested uint32, latestAvailable int) error {
-	errorId := uuid.New().String()
-	slog.Error("tick not found.", "requested:", requested, "latest:", latestAvailable, "uuid:", errorId)
-	return status.Errorf(codes.NotFound, "tick not found. [%s]", errorId)
+// isValidTransactionHash checks the format and the checksum of the (lower case) transaction hash.
+func isValidTransactionHash(s string) bool {
+	if len(s) == 60 && !strings.ContainsFunc(s, func(r rune) bool {
+		return r < 'a' || r > 'z'
+	}) {
+		id := common.Identity(s)
+		digest, err := id.ToPubKey(true)
+		if err != nil {
+			return false
+		}
+		err = id.FromPubKey(digest, true)
+		return err == nil && id.String() == s
+	}
+	return false
 }
 
-func retrieveEventsError(internalMessage string, args ...any) error {
-	errorId := uuid.New().String()
-	slog.Error(internalMessage, "uuid", errorId, args)
-	return status.Errorf(codes.Internal, "error retrieving events. [%s]", errorId)
+// isValidAssetName checks for 1 to 7 upper case letters or digits, starting with a letter.
+func isValidAssetName(s string) bool {
+	if len(s) == 0 || len(s) > 7 || s[0] < 'A' || s[0] > 'Z' {
+		return false
+	}
+	return !strings.ContainsFunc(s, func(r rune) bool {
+		return (r < 'A' || r > 'Z') && (r < '0' || r > '9')
+	})
 }
 
 func (s *Server) Start() error {
-	srv := grpc.NewServer(
-		grpc.MaxRecvMsgSize(600*1024*1024),
-		grpc.MaxSendMsgSize(600*1024*1024),
-	)
+	serverOptions := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(600 * 1024 * 1024),
+		grpc.MaxSendMsgSize(600 * 1024 * 1024),
+		grpc.ChainUnaryInterceptor(s.interceptor.UnaryInterceptor()),
+		grpc.ChainStreamInterceptor(s.interceptor.StreamInterceptor()),
+	}
+	if s.auth != nil {
+		serverOptions = append(serverOptions,
+			grpc.ChainUnaryInterceptor(s.auth.UnaryInterceptor()),
+			grpc.ChainStreamInterceptor(s.auth.StreamInterceptor()),
+		)
+	}
+	srv := grpc.NewServer(serverOptions...)
 	proto.RegisterTransferServiceServer(srv, s)
+	v2.RegisterTransferServiceServer(srv, &ServerV2{server: s})
 	reflection.Register(srv)
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(srv, healthServer)
+	s.health.updateServingStatus(healthServer)
+	go s.health.updateServingStatusInLoop(healthServer)
 
 	lis, err := net.Listen("tcp", s.listenAddrGRPC)
 	if err != nil {
 		slog.Fatalf("failed to listen: %v", err)
 	}
+	if s.tlsConfig != nil {
+		grpcTlsConfig := s.tlsConfig.Clone()
+		grpcTlsConfig.NextProtos = []string{"h2"}
+		lis = tls.NewListener(lis, grpcTlsConfig)
+	}
 
 	go func() {
 		if err := srv.Serve(lis); err != nil {
@@ -218,28 +649,103 @@ func (s *Server) Start() error {
 	}()
 
 	if s.listenAddrHTTP != "" {
+		marshaler := &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{EmitDefaultValues: true, EmitUnpopulated: true},
+		}
+		mux := runtime.NewServeMux(
+			runtime.WithMarshalerOption(runtime.MIMEWildcard, marshaler),
+			runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+			runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+			runtime.WithErrorHandler(retryAfterErrorHandler),
+		)
+		// the gateway connects to the grpc server in memory, so that no plaintext listener and no client certificate
+		// is needed
+		gatewayLis := bufconn.Listen(gatewayBufferSize)
 		go func() {
-			mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
-				MarshalOptions: protojson.MarshalOptions{EmitDefaultValues: true, EmitUnpopulated: true},
-			}))
-			opts := []grpc.DialOption{
-				grpc.WithTransportCredentials(insecure.NewCredentials()),
-				grpc.WithDefaultCallOptions(
-					grpc.MaxCallRecvMsgSize(600*1024*1024),
-					grpc.MaxCallSendMsgSize(600*1024*1024),
-				),
-			}
-
-			if err := proto.RegisterTransferServiceHandlerFromEndpoint(
-				context.Background(),
-				mux,
-				s.listenAddrGRPC,
-				opts,
-			); err != nil {
+			if err := srv.Serve(gatewayLis); err != nil {
 				panic(err)
 			}
+		}()
+		opts := []grpc.DialOption{
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return gatewayLis.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithDefaultCallOptions(
+				grpc.MaxCallRecvMsgSize(600*1024*1024),
+				grpc.MaxCallSendMsgSize(600*1024*1024),
+			),
+		}
+
+		if err := proto.RegisterTransferServiceHandlerFromEndpoint(
+			context.Background(),
+			mux,
+			gatewayTarget,
+			opts,
+		); err != nil {
+			return errors.Wrap(err, "registering gateway handlers")
+		}
+
+		if err := v2.RegisterTransferServiceHandlerFromEndpoint(
+			context.Background(),
+			mux,
+			gatewayTarget,
+			opts,
+		); err != nil {
+			return errors.Wrap(err, "registering v2 gateway handlers")
+		}
+
+		// custom handlers don't pass the grpc interceptors
+		streamHandler, exportHandler := s.handleTransferStream(mux, marshaler), s.handleExport(mux, marshaler)
+		if s.auth != nil {
+			streamHandler = s.auth.httpHandler(mux, marshaler, streamHandler)
+			exportHandler = s.auth.httpHandler(mux, marshaler, exportHandler)
+		}
+
+		if err := mux.HandlePath(http.MethodGet, "/api/v1/stream/transfers", streamHandler); err != nil {
+			return errors.Wrap(err, "registering stream handler")
+		}
+
+		if err := mux.HandlePath(http.MethodGet, "/api/v1/entities/{identity}/export", exportHandler); err != nil {
+			return errors.Wrap(err, "registering export handler")
+		}
+
+		if err := mux.HandlePath(http.MethodGet, "/status/live", handleLive(marshaler)); err != nil {
+			return errors.Wrap(err, "registering liveness handler")
+		}
 
-			if err := http.ListenAndServe(s.listenAddrHTTP, mux); err != nil {
+		if err := mux.HandlePath(http.MethodGet, "/status/ready", s.health.handleReady(marshaler)); err != nil {
+			return errors.Wrap(err, "registering readiness handler")
+		}
+
+		if err := mux.HandlePath(http.MethodGet, "/api/openapi.json", handleOpenApiSpec); err != nil {
+			return errors.Wrap(err, "registering openapi handler")
+		}
+
+		if err := mux.HandlePath(http.MethodGet, "/api/docs", handleApiExplorer); err != nil {
+			return errors.Wrap(err, "registering api explorer handler")
+		}
+
+		httpLis, err := net.Listen("tcp", s.listenAddrHTTP)
+		if err != nil {
+			return errors.Wrapf(err, "listening on [%s]", s.listenAddrHTTP)
+		}
+
+		var handler http.Handler = mux
+		if s.cache != nil {
+			handler = s.cache.handler(mux, marshaler, s.auth, s.repository)
+		}
+		handler = requestIdHandler(handler)
+
+		go func() {
+			httpServer := &http.Server{Handler: handler, TLSConfig: s.tlsConfig}
+			var err error
+			if s.tlsConfig != nil {
+				err = httpServer.ServeTLS(httpLis, "", "") // certificates from tls config
+			} else {
+				err = httpServer.Serve(httpLis)
+			}
+			if err != nil {
 				panic(err)
 			}
 		}()
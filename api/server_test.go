@@ -2,29 +2,126 @@ package api
 
 import (
 	"context"
+	"database/sql"
 	"flag"
+	"go-transfers/broadcast"
+	"go-transfers/db"
 	"go-transfers/proto"
 	"io"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gookit/slog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 type FakeRepository struct {
 }
 
-func (f FakeRepository) GetAssetChangeEventsForEntity(_ context.Context, _ string) ([]*proto.AssetChangeEvent, error) {
+func (f FakeRepository) GetAssetChangeEventsForEntity(_ context.Context, _ string, _ db.EntityFilter) ([]*proto.AssetChangeEvent, error) {
 	return []*proto.AssetChangeEvent{}, nil
 }
 
-func (f FakeRepository) GetQuTransferEventsForEntity(_ context.Context, _ string) ([]*proto.QuTransferEvent, error) {
+func (f FakeRepository) GetQuTransferEventsForEntity(_ context.Context, _ string, _ db.EntityFilter) ([]*proto.QuTransferEvent, error) {
 	return []*proto.QuTransferEvent{}, nil
 }
 
+func (f FakeRepository) GetQuBalanceHistoryForEntity(_ context.Context, _ string, filter db.EntityFilter) ([]*proto.QuBalanceChange, error) {
+	changes := []*proto.QuBalanceChange{}
+	for i := range filter.Limit { // always more than one page
+		changes = append(changes, &proto.QuBalanceChange{Tick: uint32(1000 - i), Change: "1", Balance: strconv.Itoa(filter.Limit - i)})
+	}
+	return changes, nil
+}
+
+func (f FakeRepository) GetAssetHoldingsForEntity(_ context.Context, _ string) ([]*proto.AssetHolding, error) {
+	return []*proto.AssetHolding{}, nil
+}
+
+func (f FakeRepository) GetAssetHolders(_ context.Context, _, _ string) ([]*proto.AssetHolder, error) {
+	return []*proto.AssetHolder{}, nil
+}
+
+func (f FakeRepository) GetAssets(_ context.Context) ([]*proto.Asset, error) {
+	return []*proto.Asset{}, nil
+}
+
+func (f FakeRepository) GetAssetChangeEventsForAsset(_ context.Context, _, _ string, _ db.EntityFilter) ([]*proto.AssetChangeEvent, error) {
+	return []*proto.AssetChangeEvent{}, nil
+}
+
+func (f FakeRepository) CountAssetChangeEventsForAsset(_ context.Context, _, _ string, _ db.EntityFilter) (int, error) {
+	return 0, nil
+}
+
+func (f FakeRepository) CountQuTransferEventsForEntity(_ context.Context, _ string, _ db.EntityFilter) (int, error) {
+	return 0, nil
+}
+
+func (f FakeRepository) CountAssetChangeEventsForEntity(_ context.Context, _ string, _ db.EntityFilter) (int, error) {
+	return 0, nil
+}
+
+func (f FakeRepository) GetQuTransferEventsForTransaction(_ context.Context, _ string) ([]*proto.QuTransferEvent, error) {
+	return []*proto.QuTransferEvent{}, nil
+}
+
+func (f FakeRepository) GetAssetChangeEventsForTransaction(_ context.Context, _ string) ([]*proto.AssetChangeEvent, error) {
+	return []*proto.AssetChangeEvent{}, nil
+}
+
+func (f FakeRepository) GetQuTransferEventsForTickRange(_ context.Context, fromTick, _ uint32, _ db.TransferFilter) ([]*proto.QuTransferEvent, error) {
+	return []*proto.QuTransferEvent{{Tick: fromTick, EventId: 1}}, nil
+}
+
+func (f FakeRepository) GetAssetChangeEventsForTickRange(_ context.Context, _, _ uint32, _ db.TransferFilter) ([]*proto.AssetChangeEvent, error) {
+	return []*proto.AssetChangeEvent{}, nil
+}
+
+func (f FakeRepository) GetQuTransferEventsForEntities(_ context.Context, identities []string, fromTick, _ uint32) ([]*db.EntityQuTransferEvent, error) {
+	return []*db.EntityQuTransferEvent{
+		{Identity: identities[0], QuTransferEvent: &proto.QuTransferEvent{Tick: fromTick, DestinationId: identities[0], Amount: 1}},
+	}, nil
+}
+
+func (f FakeRepository) GetAssetChangeEventsForEntities(_ context.Context, _ []string, _, _ uint32) ([]*db.EntityAssetChangeEvent, error) {
+	return []*db.EntityAssetChangeEvent{}, nil
+}
+
+func (f FakeRepository) GetCounterpartiesForEntity(_ context.Context, _ string, _ db.EntityFilter) ([]db.CounterpartyVolume, error) {
+	return []db.CounterpartyVolume{
+		{Counterparty: "A", TransferCount: 2, Incoming: "36893488147419103230", Outgoing: "0", FirstTick: 5, LastTick: 7},
+		{Counterparty: "A", Issuer: "I", Name: "QX", TransferCount: 1, Incoming: "0", Outgoing: "3", FirstTick: 3, LastTick: 3},
+		{Counterparty: "B", TransferCount: 1, Incoming: "0", Outgoing: "1", FirstTick: 9, LastTick: 9},
+	}, nil
+}
+
+func (f FakeRepository) GetTransferEdges(_ context.Context, identities []string, _ bool, _ db.EntityFilter, _ uint64, _ int) ([]db.TransferEdge, error) {
+	var edges []db.TransferEdge
+	for _, identity := range identities { // every node sends to the same node
+		edges = append(edges, db.TransferEdge{Source: identity, Destination: "CFBMEMZOIDEXQAUXYYSZIURADQLAPWPMNJXQSNVQZAHYVOPYUKKJBJUCTVJL", Amount: "10", TransferCount: 1})
+	}
+	return edges, nil
+}
+
+func (f FakeRepository) ExportEventsForEntity(_ context.Context, _ string, fromTick, _ uint32, handle func(row *db.ExportRow) error) error {
+	return handle(&db.ExportRow{
+		Tick:            fromTick,
+		Timestamp:       sql.NullTime{Time: time.Date(2025, time.March, 14, 12, 30, 0, 0, time.UTC), Valid: true},
+		TransactionHash: "hash",
+		EventId:         1,
+		Direction:       "incoming",
+		Counterparty:    "SOURCE",
+		Amount:          42,
+	})
+}
+
 func (f FakeRepository) GetAssetChangeEventsForTick(_ context.Context, _ int) ([]*proto.AssetChangeEvent, error) {
 	return []*proto.AssetChangeEvent{}, nil
 }
@@ -33,18 +130,49 @@ func (f FakeRepository) GetQuTransferEventsForTick(_ context.Context, _ int) ([]
 	return []*proto.QuTransferEvent{}, nil
 }
 
+func (f FakeRepository) GetTransferEventsForTick(_ context.Context, tickNumber uint32) ([]*db.TransferEvent, error) {
+	return []*db.TransferEvent{
+		{Tick: tickNumber, Epoch: 150, TransactionHash: "hash", EventId: 1, EventType: 0, Source: "SOURCE", Destination: "DESTINATION", Amount: 42},
+		{Tick: tickNumber, Epoch: 150, TransactionHash: "hash", EventId: 2, EventType: 2, Source: "SOURCE", Destination: "DESTINATION", Amount: 7, Issuer: "ISSUER", Name: "QX", NumberOfDecimals: 2},
+	}, nil
+}
+
+func (f FakeRepository) GetTransferEventsForTransaction(_ context.Context, _ string) ([]*db.TransferEvent, error) {
+	return []*db.TransferEvent{}, nil
+}
+
+func (f FakeRepository) GetTransferEventsForEntity(_ context.Context, _ string, _ []uint32, filter db.EntityFilter) ([]*db.TransferEvent, error) {
+	var events []*db.TransferEvent
+	for i := range filter.Limit { // always more than one page
+		events = append(events, &db.TransferEvent{Tick: 1000, EventId: uint64(filter.Limit - i), Source: "SOURCE", Destination: "DESTINATION", Amount: 1})
+	}
+	return events, nil
+}
+
 func (f FakeRepository) GetLatestTick(_ context.Context) (int, error) {
 	return 1234, nil
 }
 
+func (f FakeRepository) GetEpochStartTick(_ context.Context) (int, error) {
+	return 2000, nil
+}
+
 func TestMain(m *testing.M) {
 
 	// Start server
-	srv := NewServer("0.0.0.0:8081", "0.0.0.0:8080", &FakeRepository{})
+	srv := NewServer("0.0.0.0:8081", "0.0.0.0:8080", &FakeRepository{}, broadcast.NewTickBroadcaster(), nil, nil, nil, nil, nil)
 	err := srv.Start()
 	if err != nil {
 		os.Exit(-1)
 	}
+	err = startAuthenticatedServer()
+	if err != nil {
+		os.Exit(-1)
+	}
+	err = NewAdminServer("localhost:8083", &FakeAdminRepository{}).Start()
+	if err != nil {
+		os.Exit(-1)
+	}
 
 	flag.Parse()
 	exitCode := m.Run()
@@ -107,10 +235,193 @@ func TestServer_GetQuTransfersForEntity_thenStatusOk(t *testing.T) {
 	callServiceVerifyNoError(t, "http://localhost:8080/api/v1/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/events/qu-transfers")
 }
 
+func TestServer_GetQuTransfersForEntity_givenTimeRange_thenStatusOk(t *testing.T) {
+	callServiceVerifyNoError(t, "http://localhost:8080/api/v1/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/events/qu-transfers?from_time=2025-03-01T00:00:00Z&to_time=2025-04-01T00:00:00Z")
+}
+
+func TestServer_GetQuTransfersForEntity_givenInvalidTimeRange_thenBadRequest(t *testing.T) {
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/events/qu-transfers?from_time=2025-04-01T00:00:00Z&to_time=2025-03-01T00:00:00Z", http.StatusBadRequest)
+}
+
+func TestServer_GetQuTransfersForEntity_givenPageToken_thenStatusOk(t *testing.T) {
+	callServiceVerifyNoError(t, "http://localhost:8080/api/v1/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/events/qu-transfers?page_size=10&page_token="+encodePageToken(db.Cursor{Tick: 1234, EventId: 42}))
+}
+
+func TestServer_GetQuTransfersForEntity_givenInvalidPageToken_thenBadRequest(t *testing.T) {
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/events/qu-transfers?page_token=foo", http.StatusBadRequest)
+}
+
+func TestServer_GetQuTransfersForEntity_givenTickRangeAndDirection_thenStatusOk(t *testing.T) {
+	callServiceVerifyNoError(t, "http://localhost:8080/api/v1/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/events/qu-transfers?from_tick=1000&to_tick=1234&direction=INCOMING")
+}
+
+func TestServer_GetAssetTransfersForEntity_givenInvalidTickRange_thenBadRequest(t *testing.T) {
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/events/asset-transfers?from_tick=1234&to_tick=1000", http.StatusBadRequest)
+}
+
 func TestServer_GetQuTransfersForEntity_givenInvalidIdentity_thenBadRequest(t *testing.T) {
 	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/entities/BLAH/events/qu-transfers", http.StatusBadRequest)
 }
 
+func TestServer_GetQuBalanceHistoryForEntity_thenStatusOk(t *testing.T) {
+	callServiceVerifyNoError(t, "http://localhost:8080/api/v1/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/balance-history")
+}
+
+func TestServer_GetQuBalanceHistoryForEntity_givenPageSize_thenReturnNextPageToken(t *testing.T) {
+	response, err := http.Get("http://localhost:8080/api/v1/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/balance-history?page_size=2")
+	require.NoError(t, err)
+	body, err := readBody(response.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	var history proto.QuBalanceHistoryResponse
+	require.NoError(t, protojson.Unmarshal(body, &history))
+	require.Len(t, history.GetChanges(), 2)
+	assert.Equal(t, uint32(999), history.GetChanges()[1].GetTick())
+	assert.Equal(t, "2", history.GetChanges()[1].GetBalance())
+	cursor, err := decodePageToken(history.GetNextPageToken())
+	require.NoError(t, err)
+	assert.Equal(t, db.Cursor{Tick: 999}, *cursor)
+}
+
+func TestServer_GetQuBalanceHistoryForEntity_givenInvalidIdentity_thenBadRequest(t *testing.T) {
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/entities/BLAH/balance-history", http.StatusBadRequest)
+}
+
+func TestServer_GetCounterpartiesForEntity_thenGroupByCounterparty(t *testing.T) {
+	response, err := http.Get("http://localhost:8080/api/v1/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/counterparties?limit=10")
+	require.NoError(t, err)
+	defer response.Body.Close()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	body, err := readBody(response.Body)
+	require.NoError(t, err)
+	var counterparties proto.CounterpartiesResponse
+	require.NoError(t, protojson.Unmarshal(body, &counterparties))
+	require.Len(t, counterparties.GetCounterparties(), 2)
+	first := counterparties.GetCounterparties()[0]
+	assert.Equal(t, "A", first.GetIdentity())
+	assert.Equal(t, uint64(3), first.GetTransferCount())
+	assert.Equal(t, uint32(3), first.GetFirstTick())
+	assert.Equal(t, uint32(7), first.GetLastTick())
+	assert.Len(t, first.GetVolumes(), 2)
+	assert.Equal(t, "36893488147419103230", first.GetVolumes()[0].GetIncomingAmount()) // above uint64
+	assert.Equal(t, "B", counterparties.GetCounterparties()[1].GetIdentity())
+}
+
+func TestServer_GetCounterpartiesForEntity_givenInvalidRequest_thenBadRequest(t *testing.T) {
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/entities/BLAH/counterparties", http.StatusBadRequest)
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/counterparties?from_tick=2&to_tick=1", http.StatusBadRequest)
+}
+
+func TestServer_GetAssetHoldingsForEntity_thenStatusOk(t *testing.T) {
+	callServiceVerifyNoError(t, "http://localhost:8080/api/v1/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/assets")
+}
+
+func TestServer_GetAssetHoldingsForEntity_givenInvalidIdentity_thenBadRequest(t *testing.T) {
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/entities/BLAH/assets", http.StatusBadRequest)
+}
+
+func TestServer_GetAssetHolders_thenStatusOk(t *testing.T) {
+	callServiceVerifyNoError(t, "http://localhost:8080/api/v1/assets/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/QX/holders")
+}
+
+func TestServer_GetAssetHolders_givenInvalidAssetName_thenBadRequest(t *testing.T) {
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/assets/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/qx/holders", http.StatusBadRequest)
+}
+
+func TestServer_GetAssets_thenStatusOk(t *testing.T) {
+	callServiceVerifyNoError(t, "http://localhost:8080/api/v1/assets")
+}
+
+func TestServer_GetAssetTransfersForAsset_thenStatusOk(t *testing.T) {
+	callServiceVerifyNoError(t, "http://localhost:8080/api/v1/assets/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/QX/events/asset-transfers?from_tick=1&to_tick=2&page_size=10")
+}
+
+func TestServer_GetAssetTransfersForAsset_givenInvalidTickRange_thenBadRequest(t *testing.T) {
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/assets/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/QX/events/asset-transfers?from_tick=2&to_tick=1", http.StatusBadRequest)
+}
+
+func TestServer_GetAssetTransfersForAsset_givenInvalidIssuer_thenBadRequest(t *testing.T) {
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/assets/BLAH/QX/events/asset-transfers", http.StatusBadRequest)
+}
+
+//goland:noinspection SpellCheckingInspection
+func TestServer_GetTransfersForTransaction_thenStatusOk(t *testing.T) {
+	callServiceVerifyNoError(t, "http://localhost:8080/api/v1/transactions/vdsdogcqzziknbjhdunbcbwvbwddxkoknwbmsruhuelozanrbxonntkgofql/events")
+}
+
+func TestServer_GetTransfersForTransaction_givenInvalidHash_thenBadRequest(t *testing.T) {
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/transactions/BLAH/events", http.StatusBadRequest)
+}
+
+func TestServer_GetEventsForTickRange_thenStatusOk(t *testing.T) {
+	callServiceVerifyNoError(t, "http://localhost:8080/api/v1/events?from_tick=1000&to_tick=1234&page_size=10")
+}
+
+func TestServer_GetEventsForTickRange_givenInvalidRange_thenBadRequest(t *testing.T) {
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/events?from_tick=20&to_tick=10", http.StatusBadRequest)
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/events?from_tick=1&to_tick=1001", http.StatusBadRequest)
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/events?from_tick=1&to_tick=2&page_token=foo", http.StatusBadRequest)
+}
+
+func TestServer_GetEventsForTickRange_givenUnavailableTick_thenNotFound(t *testing.T) {
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/events?from_tick=1234&to_tick=1235", http.StatusNotFound)
+}
+
+//goland:noinspection SpellCheckingInspection
+func TestServer_GetTransfersForEntities_thenGroupByEntity(t *testing.T) {
+	response, err := http.Post("http://localhost:8080/api/v1/entities/transfers", "application/json", strings.NewReader(
+		`{"identities":["AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB","CFBMEMZOIDEXQAUXYYSZIURADQLAPWPMNJXQSNVQZAHYVOPYUKKJBJUCTVJL"],"from_tick":10,"to_tick":20}`))
+	require.NoError(t, err)
+	defer response.Body.Close()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	body, err := readBody(response.Body)
+	require.NoError(t, err)
+	var transfers proto.EntitiesTransfersResponse
+	require.NoError(t, protojson.Unmarshal(body, &transfers))
+	require.Len(t, transfers.GetEntities(), 2)
+	assert.Equal(t, "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB", transfers.GetEntities()[0].GetIdentity())
+	assert.Len(t, transfers.GetEntities()[0].GetQuTransfers(), 1)
+	assert.Equal(t, "CFBMEMZOIDEXQAUXYYSZIURADQLAPWPMNJXQSNVQZAHYVOPYUKKJBJUCTVJL", transfers.GetEntities()[1].GetIdentity())
+	assert.Empty(t, transfers.GetEntities()[1].GetQuTransfers())
+}
+
+func TestServer_GetTransfersForEntities_givenInvalidRequest_thenBadRequest(t *testing.T) {
+	for _, body := range []string{
+		`{"identities":[],"from_tick":10,"to_tick":20}`,
+		`{"identities":["BLAH"],"from_tick":10,"to_tick":20}`,
+		`{"identities":["AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB"],"from_tick":20,"to_tick":10}`,
+		`{"identities":["AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB"],"from_tick":1,"to_tick":10001}`,
+	} {
+		response, err := http.Post("http://localhost:8080/api/v1/entities/transfers", "application/json", strings.NewReader(body))
+		require.NoError(t, err)
+		_ = response.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode, body)
+	}
+}
+
+//goland:noinspection SpellCheckingInspection
+func Test_IsValidTransactionHash(t *testing.T) {
+	assert.True(t, isValidTransactionHash("vdsdogcqzziknbjhdunbcbwvbwddxkoknwbmsruhuelozanrbxonntkgofql"))
+	assert.False(t, isValidTransactionHash("VDSDOGCQZZIKNBJHDUNBCBWVBWDDXKOKNWBMSRUHUELOZANRBXONNTKGOFQL"))
+	assert.False(t, isValidTransactionHash("vdsdogcqzziknbjhdunbcbwvbwddxkoknwbmsruhuelozanrbxonntkgofqk"))
+	assert.False(t, isValidTransactionHash("vdsdogcqzziknbjhdunbcbwvbwddxkoknwbmsruhuelozanrbxonntkgofq"))
+	assert.False(t, isValidTransactionHash("vdsdogcqzziknbjhdunbcbwvbwddxkoknwbmsruhuelozanrbxonntkgof1l"))
+}
+
+func Test_IsValidAssetName(t *testing.T) {
+	assert.True(t, isValidAssetName("QX"))
+	assert.True(t, isValidAssetName("QWALLET"))
+	assert.True(t, isValidAssetName("GARTH2"))
+	assert.False(t, isValidAssetName(""))
+	assert.False(t, isValidAssetName("qx"))
+	assert.False(t, isValidAssetName("2QX"))
+	assert.False(t, isValidAssetName("TOOLONGX"))
+	assert.False(t, isValidAssetName("Q-X"))
+}
+
 //goland:noinspection SpellCheckingInspection
 func Test_IsValidIdentity(t *testing.T) {
 	assert.False(t, isValidIdentity("cfBMEMZOIDEXQAUXYYSZIURADQLAPWPMNJXQSNVQZAHYVOPYUKKJBJUCTVJL"))
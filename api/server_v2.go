@@ -0,0 +1,166 @@
+package api
+
+import (
+	"context"
+	"go-transfers/db"
+	"go-transfers/proto"
+	"go-transfers/proto/v2"
+
+	"github.com/gookit/slog"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// event types of the database
+const (
+	eventTypeQuTransfer            = 0
+	eventTypeAssetOwnershipChange  = 2
+	eventTypeAssetPossessionChange = 3
+)
+
+// ServerV2 serves the v2 api with one event model for all transfer types. It shares the repository and the
+// validation with the v1 server.
+type ServerV2 struct {
+	v2.UnimplementedTransferServiceServer
+	server *Server
+}
+
+func (s *ServerV2) GetTransfersForTick(ctx context.Context, request *v2.TickRequest) (*v2.TransfersResponse, error) {
+	tickNumber := request.GetTick()
+	latestTick, err := s.server.repository.GetLatestTick(ctx)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting latest tick.", "error", err)
+	}
+	if latestTick < int(tickNumber) {
+		return nil, s.server.tickNotFound(ctx, tickNumber, latestTick)
+	}
+	slog.Debug("Get transfers", "tick", tickNumber, "latest", latestTick)
+
+	events, err := s.server.repository.GetTransferEventsForTick(ctx, tickNumber)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting transfer events", "tickNumber", tickNumber, "error", err)
+	}
+
+	response := v2.TransfersResponse{LatestTick: uint32(latestTick), Events: toTransferEvents(events)}
+	return &response, nil
+}
+
+func (s *ServerV2) GetTransfersForTransaction(ctx context.Context, request *v2.TransactionRequest) (*v2.TransfersResponse, error) {
+	hash := request.GetHash()
+	if !isValidTransactionHash(hash) {
+		return nil, invalidArgument(ctx, "hash", errors.New("invalid transaction hash"))
+	}
+	latestTick, err := s.server.repository.GetLatestTick(ctx)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting latest tick.", "error", err)
+	}
+	slog.Debug("Get transaction transfers", "hash", hash, "latest", latestTick)
+
+	events, err := s.server.repository.GetTransferEventsForTransaction(ctx, hash)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting transfer events", "hash", hash, "error", err)
+	}
+
+	response := v2.TransfersResponse{LatestTick: uint32(latestTick), Events: toTransferEvents(events)}
+	return &response, nil
+}
+
+func (s *ServerV2) GetTransfersForEntity(ctx context.Context, request *v2.EntityRequest) (*v2.EntityTransfersResponse, error) {
+	identity := request.GetIdentity()
+	if !isValidIdentity(identity) {
+		return nil, invalidIdentity(ctx, "identity", identity)
+	}
+	if _, ok := v2.Direction_name[int32(request.GetDirection())]; !ok {
+		return nil, invalidArgument(ctx, "direction", errors.Errorf("unknown direction [%d]", request.GetDirection()))
+	}
+	eventTypes, err := dbEventTypes(request.GetTypes())
+	if err != nil {
+		return nil, invalidArgument(ctx, "types", err)
+	}
+	filter := db.EntityFilter{Direction: proto.Direction(request.GetDirection())} // same values
+	err = pagedTickFilter(ctx, &filter, request.GetFromTick(), request.GetToTick(), request.GetPageSize(), request.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	latestTick, err := s.server.repository.GetLatestTick(ctx)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting latest tick.", "error", err)
+	}
+	slog.Debug("Get transfers", "entity", identity, "latest", latestTick)
+
+	events, err := s.server.repository.GetTransferEventsForEntity(ctx, identity, eventTypes, filter)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting transfer events", "identity", identity, "error", err)
+	}
+	transferEvents, nextPageToken := nextPage(toTransferEvents(events), filter.Limit-1)
+
+	response := v2.EntityTransfersResponse{
+		LatestTick:    uint32(latestTick),
+		Events:        transferEvents,
+		NextPageToken: nextPageToken,
+	}
+	return &response, nil
+}
+
+// dbEventTypes maps the requested event types to the types of the database. No types means all types.
+func dbEventTypes(types []v2.EventType) ([]uint32, error) {
+	var eventTypes []uint32
+	for _, eventType := range types {
+		switch eventType {
+		case v2.EventType_EVENT_TYPE_QU_TRANSFER:
+			eventTypes = append(eventTypes, eventTypeQuTransfer)
+		case v2.EventType_EVENT_TYPE_ASSET_OWNERSHIP_CHANGE:
+			eventTypes = append(eventTypes, eventTypeAssetOwnershipChange)
+		case v2.EventType_EVENT_TYPE_ASSET_POSSESSION_CHANGE:
+			eventTypes = append(eventTypes, eventTypeAssetPossessionChange)
+		default:
+			return nil, errors.Errorf("unsupported event type [%d]", eventType)
+		}
+	}
+	return eventTypes, nil
+}
+
+func toTransferEvents(events []*db.TransferEvent) []*v2.TransferEvent {
+	transferEvents := make([]*v2.TransferEvent, 0, len(events))
+	for _, event := range events {
+		transferEvents = append(transferEvents, toTransferEvent(event))
+	}
+	return transferEvents
+}
+
+func toTransferEvent(event *db.TransferEvent) *v2.TransferEvent {
+	transferEvent := &v2.TransferEvent{
+		Tick:            event.Tick,
+		Epoch:           event.Epoch,
+		TransactionHash: event.TransactionHash,
+		EventId:         event.EventId,
+	}
+	if event.Timestamp.Valid {
+		transferEvent.Timestamp = timestamppb.New(event.Timestamp.Time)
+	}
+	assetTransfer := &v2.AssetTransfer{
+		Source:                  event.Source,
+		Destination:             event.Destination,
+		Issuer:                  event.Issuer,
+		Name:                    event.Name,
+		NumberOfShares:          event.Amount,
+		NumberOfDecimals:        event.NumberOfDecimals,
+		FormattedNumberOfShares: db.FormatAmount(event.Amount, event.NumberOfDecimals),
+	}
+	switch event.EventType {
+	case eventTypeAssetOwnershipChange:
+		transferEvent.Type = v2.EventType_EVENT_TYPE_ASSET_OWNERSHIP_CHANGE
+		transferEvent.Payload = &v2.TransferEvent_AssetOwnershipChange{AssetOwnershipChange: assetTransfer}
+	case eventTypeAssetPossessionChange:
+		transferEvent.Type = v2.EventType_EVENT_TYPE_ASSET_POSSESSION_CHANGE
+		transferEvent.Payload = &v2.TransferEvent_AssetPossessionChange{AssetPossessionChange: assetTransfer}
+	default: // the repository only returns transfer events
+		transferEvent.Type = v2.EventType_EVENT_TYPE_QU_TRANSFER
+		transferEvent.Payload = &v2.TransferEvent_QuTransfer{QuTransfer: &v2.QuTransfer{
+			Source:      event.Source,
+			Destination: event.Destination,
+			Amount:      event.Amount,
+		}}
+	}
+	return transferEvent
+}
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"go-transfers/db"
+	"go-transfers/proto/v2"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestServerV2_GetTransfersForTick_thenReturnTransferEvents(t *testing.T) {
+	response, err := http.Get("http://localhost:8080/api/v2/ticks/1234/transfers")
+	require.NoError(t, err)
+	body, err := readBody(response.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	var transfers v2.TransfersResponse
+	require.NoError(t, protojson.Unmarshal(body, &transfers))
+	assert.Equal(t, uint32(1234), transfers.GetLatestTick())
+	require.Len(t, transfers.GetEvents(), 2)
+	assert.Equal(t, v2.EventType_EVENT_TYPE_QU_TRANSFER, transfers.GetEvents()[0].GetType())
+	assert.Equal(t, uint64(42), transfers.GetEvents()[0].GetQuTransfer().GetAmount())
+	assert.Equal(t, uint32(150), transfers.GetEvents()[0].GetEpoch())
+	assert.Equal(t, v2.EventType_EVENT_TYPE_ASSET_OWNERSHIP_CHANGE, transfers.GetEvents()[1].GetType())
+	assert.Equal(t, "QX", transfers.GetEvents()[1].GetAssetOwnershipChange().GetName())
+	assert.Equal(t, "0.07", transfers.GetEvents()[1].GetAssetOwnershipChange().GetFormattedNumberOfShares())
+	assert.Nil(t, transfers.GetEvents()[1].GetQuTransfer())
+}
+
+func TestServerV2_GetTransfersForTick_givenUnavailableTickNumber_thenReturnNotFound(t *testing.T) {
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v2/ticks/12345/transfers", http.StatusNotFound)
+}
+
+//goland:noinspection SpellCheckingInspection
+func TestServerV2_GetTransfersForTransaction_thenStatusOk(t *testing.T) {
+	callServiceVerifyNoError(t, "http://localhost:8080/api/v2/transactions/vdsdogcqzziknbjhdunbcbwvbwddxkoknwbmsruhuelozanrbxonntkgofql/transfers")
+}
+
+func TestServerV2_GetTransfersForTransaction_givenInvalidHash_thenBadRequest(t *testing.T) {
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v2/transactions/BLAH/transfers", http.StatusBadRequest)
+}
+
+//goland:noinspection SpellCheckingInspection
+func TestServerV2_GetTransfersForEntity_thenReturnPage(t *testing.T) {
+	response, err := http.Get("http://localhost:8080/api/v2/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/transfers?page_size=5&types=EVENT_TYPE_QU_TRANSFER&direction=DIRECTION_INCOMING")
+	require.NoError(t, err)
+	body, err := readBody(response.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	var transfers v2.EntityTransfersResponse
+	require.NoError(t, protojson.Unmarshal(body, &transfers))
+	assert.Len(t, transfers.GetEvents(), 5)
+	cursor, err := decodePageToken(transfers.GetNextPageToken())
+	require.NoError(t, err)
+	assert.Equal(t, &db.Cursor{Tick: 1000, EventId: 2}, cursor)
+}
+
+//goland:noinspection SpellCheckingInspection
+func TestServerV2_GetTransfersForEntity_givenInvalidRequest_thenBadRequest(t *testing.T) {
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v2/entities/BLAH/transfers", http.StatusBadRequest)
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v2/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/transfers?types=EVENT_TYPE_UNSPECIFIED", http.StatusBadRequest)
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v2/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/transfers?from_tick=2&to_tick=1", http.StatusBadRequest)
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v2/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/transfers?page_token=foo", http.StatusBadRequest)
+}
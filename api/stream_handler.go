@@ -0,0 +1,237 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"go-transfers/db"
+	"go-transfers/proto"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gookit/slog"
+	"github.com/gorilla/websocket"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	sseEventQuTransfer    = "qu-transfer"
+	sseEventAssetTransfer = "asset-transfer"
+	streamWriteTimeout    = 10 * time.Second
+)
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(*http.Request) bool { return true }, // public, read only data
+}
+
+// streamRequest holds the parameters of a http transfer stream.
+type streamRequest struct {
+	filter     db.TransferFilter
+	eventTypes []uint32 // empty means all
+	startTick  uint32
+	startField string     // request parameter of the start tick for error details
+	after      *db.Cursor // resume. the event of the cursor and earlier ones are skipped.
+}
+
+// handleTransferStream streams transfers as server-sent events or, if requested, via websocket. Query parameters:
+// identity and asset (ISSUER/NAME) can be repeated, event_type (0, 2, 3) can be repeated, start_tick and
+// last_event_id (tick:eventId) for resuming. The Last-Event-ID header is supported, too.
+func (s *Server) handleTransferStream(mux *runtime.ServeMux, marshaler runtime.Marshaler) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		request, err := parseStreamRequest(r)
+		if err == nil {
+			err = s.validateStartTick(r.Context(), request.startField, request.startTick)
+		}
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, marshaler, w, r, err)
+			return
+		}
+		if websocket.IsWebSocketUpgrade(r) {
+			s.streamWebSocket(w, r, request, marshaler)
+			return
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			runtime.HTTPError(r.Context(), mux, marshaler, w, r, status.Error(codes.Unimplemented, "streaming not supported"))
+			return
+		}
+		s.streamServerSentEvents(w, flusher, r, request, marshaler)
+	}
+}
+
+func (s *Server) streamServerSentEvents(w http.ResponseWriter, flusher http.Flusher, r *http.Request, request *streamRequest, marshaler runtime.Marshaler) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	err := s.streamTransfers(r.Context(), request.filter, request.startTick, func(response *proto.TickTransfersResponse) error {
+		for _, event := range orderedEvents(request.matching(response)) {
+			err := writeServerSentEvent(w, marshaler, event)
+			if err != nil {
+				return err
+			}
+		}
+		flusher.Flush()
+		return nil
+	})
+	if err != nil && r.Context().Err() == nil {
+		slog.Warn("Transfer stream failed.", "error", err)
+		_, _ = fmt.Fprintf(w, "event: error\ndata: %q\n\n", status.Convert(err).Message())
+		flusher.Flush()
+	}
+}
+
+func writeServerSentEvent(w http.ResponseWriter, marshaler runtime.Marshaler, event pageable) error {
+	name := sseEventQuTransfer
+	if _, ok := event.(*proto.AssetChangeEvent); ok {
+		name = sseEventAssetTransfer
+	}
+	data, err := marshaler.Marshal(event)
+	if err != nil {
+		return errors.Wrap(err, "marshalling event")
+	}
+	_, err = fmt.Fprintf(w, "event: %s\nid: %d:%d\ndata: %s\n\n", name, event.GetTick(), event.GetEventId(), data)
+	return errors.Wrap(err, "writing event")
+}
+
+// streamWebSocket sends one text message with the matching events per tick.
+func (s *Server) streamWebSocket(w http.ResponseWriter, r *http.Request, request *streamRequest, marshaler runtime.Marshaler) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		slog.Debug("Websocket upgrade failed.", "error", err) // upgrader already replied
+		return
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	go func() { // read until the client closes the connection
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	err = s.streamTransfers(ctx, request.filter, request.startTick, func(response *proto.TickTransfersResponse) error {
+		response = request.matching(response)
+		if response == nil {
+			return nil
+		}
+		data, err := marshaler.Marshal(response)
+		if err != nil {
+			return errors.Wrap(err, "marshalling response")
+		}
+		_ = conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+		return conn.WriteMessage(websocket.TextMessage, data)
+	})
+	if err != nil && ctx.Err() == nil {
+		slog.Warn("Transfer stream failed.", "error", err)
+		message := websocket.FormatCloseMessage(websocket.CloseInternalServerErr, status.Convert(err).Message())
+		_ = conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(streamWriteTimeout))
+	}
+}
+
+func parseStreamRequest(r *http.Request) (*streamRequest, error) {
+	ctx := r.Context()
+	query := r.URL.Query()
+	var assets []*proto.AssetKey
+	for _, asset := range query["asset"] {
+		issuer, name, found := strings.Cut(asset, "/")
+		if !found {
+			return nil, invalidArgument(ctx, "asset", errors.New("expected ISSUER/NAME"))
+		}
+		assets = append(assets, &proto.AssetKey{Issuer: issuer, Name: name})
+	}
+	filter, err := transferFilter(ctx, query["identity"], assets)
+	if err != nil {
+		return nil, err
+	}
+	request := streamRequest{filter: filter}
+
+	for _, value := range query["event_type"] {
+		eventType, err := strconv.ParseUint(value, 10, 32)
+		if err != nil || (eventType != 0 && eventType != 2 && eventType != 3) {
+			return nil, invalidArgument(ctx, "event_type", errors.Errorf("unsupported event type [%s]", value))
+		}
+		request.eventTypes = append(request.eventTypes, uint32(eventType))
+	}
+
+	if value := query.Get("start_tick"); value != "" {
+		startTick, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return nil, invalidArgument(ctx, "start_tick", err)
+		}
+		request.startTick = uint32(startTick)
+		request.startField = "start_tick"
+	}
+
+	lastEventId := query.Get("last_event_id")
+	if lastEventId == "" {
+		lastEventId = r.Header.Get("Last-Event-ID") // set by browsers on reconnect
+	}
+	if lastEventId != "" {
+		var cursor db.Cursor
+		_, err = fmt.Sscanf(lastEventId, "%d:%d", &cursor.Tick, &cursor.EventId)
+		if err != nil {
+			return nil, invalidArgument(ctx, "last_event_id", errors.New("expected tick:eventId"))
+		}
+		request.after = &cursor
+		request.startTick = cursor.Tick
+		request.startField = "last_event_id"
+	}
+	return &request, nil
+}
+
+// matching returns the events of the response, that match event type and resume position, or nil, if none match.
+func (sr *streamRequest) matching(response *proto.TickTransfersResponse) *proto.TickTransfersResponse {
+	matching := proto.TickTransfersResponse{Tick: response.GetTick()}
+	for _, event := range response.GetQuTransfers() {
+		if sr.matches(event, event.GetEventType()) {
+			matching.QuTransfers = append(matching.QuTransfers, event)
+		}
+	}
+	for _, event := range response.GetAssetChanges() {
+		if sr.matches(event, event.GetEventType()) {
+			matching.AssetChanges = append(matching.AssetChanges, event)
+		}
+	}
+	if len(matching.QuTransfers) == 0 && len(matching.AssetChanges) == 0 {
+		return nil
+	}
+	return &matching
+}
+
+func (sr *streamRequest) matches(event pageable, eventType uint32) bool {
+	if len(sr.eventTypes) > 0 && !slices.Contains(sr.eventTypes, eventType) {
+		return false
+	}
+	if sr.after != nil && event.GetTick() == sr.after.Tick && event.GetEventId() <= sr.after.EventId {
+		return false
+	}
+	return true
+}
+
+// orderedEvents returns the events of the tick ordered by event id.
+func orderedEvents(response *proto.TickTransfersResponse) []pageable {
+	var events []pageable
+	for _, event := range response.GetQuTransfers() {
+		events = append(events, event)
+	}
+	for _, event := range response.GetAssetChanges() {
+		events = append(events, event)
+	}
+	slices.SortStableFunc(events, func(a, b pageable) int {
+		return cmp.Compare(a.GetEventId(), b.GetEventId())
+	})
+	return events
+}
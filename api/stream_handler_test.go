@@ -0,0 +1,113 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"go-transfers/db"
+	"go-transfers/proto"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_StreamTransfers_givenStartTick_thenSendServerSentEvents(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost:8080/api/v1/stream/transfers?start_tick=1234&event_type=0", nil)
+	require.NoError(t, err)
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "text/event-stream", response.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(response.Body)
+	var lines []string
+	for len(lines) < 3 {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		lines = append(lines, strings.TrimSpace(line))
+	}
+	assert.Equal(t, "event: qu-transfer", lines[0])
+	assert.Equal(t, "id: 1234:1", lines[1])
+	assert.True(t, strings.HasPrefix(lines[2], "data: {"))
+}
+
+func TestServer_StreamTransfers_givenWebSocket_thenSendTick(t *testing.T) {
+	conn, _, err := websocket.DefaultDialer.Dial("ws://localhost:8080/api/v1/stream/transfers?start_tick=1234", nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, message, err := conn.ReadMessage()
+	require.NoError(t, err)
+	assert.Contains(t, string(message), `"tick":1234`)
+}
+
+func TestServer_StreamTransfers_givenInvalidAsset_thenBadRequest(t *testing.T) {
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/stream/transfers?asset=QX", http.StatusBadRequest)
+}
+
+func TestServer_StreamTransfers_givenInvalidEventType_thenBadRequest(t *testing.T) {
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/stream/transfers?event_type=1", http.StatusBadRequest)
+}
+
+func TestServer_StreamTransfers_givenStartTickTooOld_thenBadRequest(t *testing.T) {
+	// latest tick of the fake repository is 1234
+	for url, field := range map[string]string{
+		"http://localhost:8080/api/v1/stream/transfers?start_tick=1":      "start_tick",
+		"http://localhost:8080/api/v1/stream/transfers?last_event_id=1:1": "last_event_id",
+	} {
+		response, err := http.Get(url)
+		require.NoError(t, err)
+		body, err := readBody(response.Body)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode, url)
+		assert.Contains(t, string(body), `"field":"`+field+`"`, url)
+	}
+}
+
+func Test_ParseStreamRequest(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/stream/transfers?asset=AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/QX&event_type=2&start_tick=10", nil)
+	r.Header.Set("Last-Event-ID", "42:7")
+	request, err := parseStreamRequest(r)
+	require.NoError(t, err)
+	assert.Equal(t, []db.AssetKey{{Issuer: "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB", Name: "QX"}}, request.filter.Assets)
+	assert.Equal(t, []uint32{2}, request.eventTypes)
+	assert.Equal(t, &db.Cursor{Tick: 42, EventId: 7}, request.after)
+	assert.Equal(t, uint32(42), request.startTick) // resume wins
+	assert.Equal(t, "last_event_id", request.startField)
+
+	_, err = parseStreamRequest(httptest.NewRequest(http.MethodGet, "/api/v1/stream/transfers?last_event_id=foo", nil))
+	assert.Error(t, err)
+}
+
+func Test_StreamRequest_Matching(t *testing.T) {
+	request := streamRequest{eventTypes: []uint32{0}, after: &db.Cursor{Tick: 42, EventId: 1}}
+	response := &proto.TickTransfersResponse{
+		Tick:         42,
+		QuTransfers:  []*proto.QuTransferEvent{{Tick: 42, EventId: 1}, {Tick: 42, EventId: 3}},
+		AssetChanges: []*proto.AssetChangeEvent{{Tick: 42, EventId: 2, EventType: 2}},
+	}
+	assert.Equal(t, &proto.TickTransfersResponse{
+		Tick:        42,
+		QuTransfers: response.QuTransfers[1:],
+	}, request.matching(response))
+
+	request = streamRequest{eventTypes: []uint32{3}}
+	assert.Nil(t, request.matching(response))
+}
+
+func Test_OrderedEvents(t *testing.T) {
+	response := &proto.TickTransfersResponse{
+		QuTransfers:  []*proto.QuTransferEvent{{EventId: 1}, {EventId: 3}},
+		AssetChanges: []*proto.AssetChangeEvent{{EventId: 2}},
+	}
+	assert.Equal(t, []pageable{response.QuTransfers[0], response.AssetChanges[0], response.QuTransfers[1]}, orderedEvents(response))
+}
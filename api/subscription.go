@@ -0,0 +1,168 @@
+package api
+
+import (
+	"context"
+	"go-transfers/db"
+	"go-transfers/proto"
+	"time"
+
+	"github.com/gookit/slog"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+const (
+	maxFilterIdentities      = 1000
+	maxFilterAssets          = 100
+	replayTickWindow         = 100
+	maxReplayTicks           = 1000 // how far a stream can start behind the latest tick
+	subscriptionPollInterval = 5 * time.Second
+)
+
+// TickSource notifies about processed ticks.
+type TickSource interface {
+	Subscribe() (<-chan uint32, func())
+}
+
+func (s *Server) SubscribeTransfers(request *proto.SubscribeTransfersRequest, stream grpc.ServerStreamingServer[proto.TickTransfersResponse]) error {
+	ctx := stream.Context()
+	filter, err := transferFilter(ctx, request.GetIdentities(), request.GetAssets())
+	if err != nil {
+		return err
+	}
+	err = s.validateStartTick(ctx, "start_tick", request.GetStartTick())
+	if err != nil {
+		return err
+	}
+	return s.streamTransfers(ctx, filter, request.GetStartTick(), stream.Send)
+}
+
+// validateStartTick rejects start ticks, that are more than maxReplayTicks behind the latest processed tick, so that
+// streams can not replay the whole history. Start tick 0 means the next tick.
+func (s *Server) validateStartTick(ctx context.Context, field string, startTick uint32) error {
+	if startTick == 0 {
+		return nil
+	}
+	latestTick, err := s.repository.GetLatestTick(ctx)
+	if err != nil {
+		return retrieveEventsError(ctx, "getting latest tick.", "error", err)
+	}
+	if latestTick-int(startTick) > maxReplayTicks {
+		return invalidArgument(ctx, field, errors.Errorf("start tick is more than [%d] ticks behind the latest tick [%d]", maxReplayTicks, latestTick))
+	}
+	return nil
+}
+
+// streamTransfers sends the matching events per tick from the start tick on, until the context is done. Start tick 0
+// means the tick after the latest processed tick.
+func (s *Server) streamTransfers(ctx context.Context, filter db.TransferFilter, startTick uint32, send func(*proto.TickTransfersResponse) error) error {
+	var ticks <-chan uint32 // nil channel never receives
+	if s.ticks != nil {
+		var cancel func()
+		ticks, cancel = s.ticks.Subscribe() // subscribe before getting the latest tick to not miss any ticks
+		defer cancel()
+	}
+
+	latestTick, err := s.repository.GetLatestTick(ctx)
+	if err != nil {
+		return retrieveEventsError(ctx, "getting latest tick.", "error", err)
+	}
+	nextTick := startTick
+	if nextTick == 0 {
+		nextTick = uint32(latestTick) + 1
+	}
+	slog.Debug("Stream transfers", "identities", len(filter.Identities), "assets", len(filter.Assets), "start", nextTick, "latest", latestTick)
+
+	poll := time.NewTicker(subscriptionPollInterval) // in case the sync runs in another process
+	defer poll.Stop()
+	for {
+		for nextTick <= uint32(latestTick) {
+			toTick := min(nextTick+replayTickWindow-1, uint32(latestTick))
+			err = s.sendTransfers(ctx, send, nextTick, toTick, filter)
+			if err != nil {
+				return err
+			}
+			nextTick = toTick + 1
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case tick := <-ticks:
+			latestTick = max(latestTick, int(tick))
+		case <-poll.C:
+			latestTick, err = s.repository.GetLatestTick(ctx)
+			if err != nil {
+				return retrieveEventsError(ctx, "getting latest tick.", "error", err)
+			}
+		}
+	}
+}
+
+func (s *Server) sendTransfers(ctx context.Context, send func(*proto.TickTransfersResponse) error, fromTick, toTick uint32, filter db.TransferFilter) error {
+	quTransfers, err := s.repository.GetQuTransferEventsForTickRange(ctx, fromTick, toTick, filter)
+	if err != nil {
+		return retrieveEventsError(ctx, "getting qu transfer events", "from", fromTick, "to", toTick, "error", err)
+	}
+	assetChanges, err := s.repository.GetAssetChangeEventsForTickRange(ctx, fromTick, toTick, filter)
+	if err != nil {
+		return retrieveEventsError(ctx, "getting asset change events", "from", fromTick, "to", toTick, "error", err)
+	}
+	for _, response := range groupByTick(quTransfers, assetChanges) {
+		err = send(response)
+		if err != nil {
+			return errors.Wrapf(err, "sending transfers of tick [%d]", response.GetTick())
+		}
+	}
+	return nil
+}
+
+// groupByTick merges the events, that need to be ordered by tick, into one response per tick.
+func groupByTick(quTransfers []*proto.QuTransferEvent, assetChanges []*proto.AssetChangeEvent) []*proto.TickTransfersResponse {
+	var responses []*proto.TickTransfersResponse
+	responseFor := func(tick uint32) *proto.TickTransfersResponse {
+		if len(responses) == 0 || responses[len(responses)-1].GetTick() != tick {
+			responses = append(responses, &proto.TickTransfersResponse{Tick: tick})
+		}
+		return responses[len(responses)-1]
+	}
+	i, j := 0, 0
+	for i < len(quTransfers) || j < len(assetChanges) {
+		if j == len(assetChanges) || (i < len(quTransfers) && quTransfers[i].GetTick() <= assetChanges[j].GetTick()) {
+			response := responseFor(quTransfers[i].GetTick())
+			response.QuTransfers = append(response.QuTransfers, quTransfers[i])
+			i++
+		} else {
+			response := responseFor(assetChanges[j].GetTick())
+			response.AssetChanges = append(response.AssetChanges, assetChanges[j])
+			j++
+		}
+	}
+	return responses
+}
+
+func transferFilter(ctx context.Context, identities []string, assets []*proto.AssetKey) (db.TransferFilter, error) {
+	var filter db.TransferFilter
+	if len(identities) > maxFilterIdentities {
+		return filter, invalidArgument(ctx, "identities", errors.Errorf("more than [%d] identities", maxFilterIdentities))
+	}
+	if len(assets) > maxFilterAssets {
+		return filter, invalidArgument(ctx, "assets", errors.Errorf("more than [%d] assets", maxFilterAssets))
+	}
+	for _, identity := range identities {
+		if !isValidIdentity(identity) {
+			return filter, invalidIdentity(ctx, "identities", identity)
+		}
+	}
+	for _, asset := range assets {
+		if !isValidIdentity(asset.GetIssuer()) {
+			return filter, invalidIdentity(ctx, "assets.issuer", asset.GetIssuer())
+		}
+		if !isValidAssetName(asset.GetName()) {
+			return filter, invalidArgument(ctx, "assets", errors.New("invalid asset name"))
+		}
+		filter.Assets = append(filter.Assets, db.AssetKey{Issuer: asset.GetIssuer(), Name: asset.GetName()})
+	}
+	filter.Identities = identities
+	return filter, nil
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"context"
+	"go-transfers/proto"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+func subscribe(t *testing.T, request *proto.SubscribeTransfersRequest) (grpc.ServerStreamingClient[proto.TickTransfersResponse], func()) {
+	conn, err := grpc.NewClient("localhost:8081", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	stream, err := proto.NewTransferServiceClient(conn).SubscribeTransfers(ctx, request)
+	require.NoError(t, err)
+	return stream, func() {
+		cancel()
+		_ = conn.Close()
+	}
+}
+
+func TestServer_SubscribeTransfers_givenStartTick_thenReplay(t *testing.T) {
+	stream, closeStream := subscribe(t, &proto.SubscribeTransfersRequest{
+		Identities: []string{"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB"},
+		StartTick:  1234, // latest tick of fake repository
+	})
+	defer closeStream()
+
+	response, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1234), response.GetTick())
+	assert.Len(t, response.GetQuTransfers(), 1)
+}
+
+func TestServer_SubscribeTransfers_givenStartTickTooOld_thenInvalidArgument(t *testing.T) {
+	stream, closeStream := subscribe(t, &proto.SubscribeTransfersRequest{StartTick: 1234 - maxReplayTicks - 1})
+	defer closeStream()
+
+	_, err := stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "start_tick")
+}
+
+func TestServer_SubscribeTransfers_givenInvalidIdentity_thenInvalidArgument(t *testing.T) {
+	stream, closeStream := subscribe(t, &proto.SubscribeTransfersRequest{Identities: []string{"BLAH"}})
+	defer closeStream()
+
+	_, err := stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_SubscribeTransfers_givenInvalidAssetName_thenInvalidArgument(t *testing.T) {
+	stream, closeStream := subscribe(t, &proto.SubscribeTransfersRequest{Assets: []*proto.AssetKey{{
+		Issuer: "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB",
+		Name:   "qx",
+	}}})
+	defer closeStream()
+
+	_, err := stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func Test_GroupByTick(t *testing.T) {
+	quTransfers := []*proto.QuTransferEvent{{Tick: 1, EventId: 1}, {Tick: 1, EventId: 3}, {Tick: 3, EventId: 1}}
+	assetChanges := []*proto.AssetChangeEvent{{Tick: 1, EventId: 2}, {Tick: 2, EventId: 1}}
+
+	responses := groupByTick(quTransfers, assetChanges)
+	assert.Equal(t, []*proto.TickTransfersResponse{
+		{Tick: 1, QuTransfers: quTransfers[:2], AssetChanges: assetChanges[:1]},
+		{Tick: 2, AssetChanges: assetChanges[1:]},
+		{Tick: 3, QuTransfers: quTransfers[2:]},
+	}, responses)
+
+	assert.Empty(t, groupByTick(nil, nil))
+}
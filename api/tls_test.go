@@ -0,0 +1,68 @@
+package api
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"go-transfers/broadcast"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// selfSignedCertificate returns a server certificate for localhost and a pool, that trusts it.
+func selfSignedCertificate(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	pool := x509.NewCertPool()
+	pool.AddCert(certificate)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, pool
+}
+
+func TestServer_givenTls_thenServeGrpcAndGateway(t *testing.T) {
+	certificate, pool := selfSignedCertificate(t)
+	serverTls := &tls.Config{Certificates: []tls.Certificate{certificate}}
+	err := NewServer("localhost:8086", "localhost:8087", &FakeRepository{}, broadcast.NewTickBroadcaster(), nil, nil, nil, nil, serverTls).Start()
+	require.NoError(t, err)
+	clientTls := &tls.Config{RootCAs: pool}
+
+	// the gateway forwards to the grpc server
+	httpClient := &http.Client{Transport: &http.Transport{TLSClientConfig: clientTls}}
+	response, err := httpClient.Get("https://localhost:8087/api/v1/ticks/1/events/qu-transfers")
+	require.NoError(t, err)
+	_ = response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+
+	conn, err := grpc.NewClient("localhost:8086", grpc.WithTransportCredentials(credentials.NewTLS(clientTls)))
+	require.NoError(t, err)
+	defer conn.Close()
+	healthResponse, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, healthResponse.GetStatus())
+}
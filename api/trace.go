@@ -0,0 +1,98 @@
+package api
+
+import (
+	"context"
+	"go-transfers/db"
+	"go-transfers/proto"
+
+	"github.com/gookit/slog"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultTraceDepth  = 3
+	maxTraceDepth      = 5
+	defaultTraceFanOut = 10
+	maxTraceFanOut     = 50
+	maxTraceNodes      = 1000
+)
+
+// TraceFunds follows the qu transfers from (or to) the identity hop by hop with one query per hop. Nodes are visited
+// once, edges to already visited nodes are kept to show cycles.
+func (s *Server) TraceFunds(ctx context.Context, request *proto.TraceFundsRequest) (*proto.TraceFundsResponse, error) {
+	identity := request.GetIdentity()
+	if !isValidIdentity(identity) {
+		return nil, invalidIdentity(ctx, "identity", identity)
+	}
+	if _, ok := proto.TraceDirection_name[int32(request.GetDirection())]; !ok {
+		return nil, invalidArgument(ctx, "direction", errors.Errorf("unknown direction [%d]", request.GetDirection()))
+	}
+	fromTick, toTick := request.GetFromTick(), request.GetToTick()
+	if fromTick > 0 && toTick > 0 && fromTick > toTick {
+		return nil, invalidArgument(ctx, "to_tick", errors.New("must not be before from_tick"))
+	}
+	depth, err := boundedParam(ctx, "max_depth", request.GetMaxDepth(), defaultTraceDepth, maxTraceDepth)
+	if err != nil {
+		return nil, err
+	}
+	fanOut, err := boundedParam(ctx, "max_fan_out", request.GetMaxFanOut(), defaultTraceFanOut, maxTraceFanOut)
+	if err != nil {
+		return nil, err
+	}
+	latestTick, err := s.repository.GetLatestTick(ctx)
+	if err != nil {
+		return nil, retrieveEventsError(ctx, "getting latest tick.", "error", err)
+	}
+	slog.Debug("Trace funds", "entity", identity, "direction", request.GetDirection(), "depth", depth, "latest", latestTick)
+
+	backward := request.GetDirection() == proto.TraceDirection_BACKWARD
+	filter := db.EntityFilter{FromTick: fromTick, ToTick: toTick}
+	response := proto.TraceFundsResponse{
+		LatestTick: uint32(latestTick),
+		Nodes:      []*proto.TraceNode{{Identity: identity}},
+	}
+	visited := map[string]bool{identity: true}
+	frontier := []string{identity}
+	for hop := uint32(1); hop <= depth && len(frontier) > 0; hop++ {
+		edges, err := s.repository.GetTransferEdges(ctx, frontier, backward, filter, request.GetMinAmount(), int(fanOut))
+		if err != nil {
+			return nil, retrieveEventsError(ctx, "getting transfer edges", "identity", identity, "hop", hop, "error", err)
+		}
+		frontier = nil
+		for _, edge := range edges {
+			next := edge.Destination
+			if backward {
+				next = edge.Source
+			}
+			if !visited[next] {
+				if len(response.Nodes) >= maxTraceNodes {
+					response.Truncated = true
+					continue // skip edges to nodes, that are not part of the graph
+				}
+				visited[next] = true
+				response.Nodes = append(response.Nodes, &proto.TraceNode{Identity: next, Depth: hop})
+				frontier = append(frontier, next)
+			}
+			response.Edges = append(response.Edges, &proto.TraceEdge{
+				SourceId:      edge.Source,
+				DestinationId: edge.Destination,
+				Amount:        edge.Amount,
+				TransferCount: uint64(edge.TransferCount),
+				FirstTick:     edge.FirstTick,
+				LastTick:      edge.LastTick,
+			})
+		}
+	}
+	return &response, nil
+}
+
+// boundedParam returns the default value, if the parameter is not set, or an error, if it exceeds the maximum.
+func boundedParam(ctx context.Context, field string, value, defaultValue, maxValue uint32) (uint32, error) {
+	if value == 0 {
+		return defaultValue, nil
+	}
+	if value > maxValue {
+		return 0, invalidArgument(ctx, field, errors.Errorf("exceeds [%d]", maxValue))
+	}
+	return value, nil
+}
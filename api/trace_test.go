@@ -0,0 +1,37 @@
+package api
+
+import (
+	"go-transfers/proto"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+//goland:noinspection SpellCheckingInspection
+func TestServer_TraceFunds_thenReturnGraph(t *testing.T) {
+	response, err := http.Get("http://localhost:8080/api/v1/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/trace?max_depth=3")
+	require.NoError(t, err)
+	defer response.Body.Close()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	body, err := readBody(response.Body)
+	require.NoError(t, err)
+	var graph proto.TraceFundsResponse
+	require.NoError(t, protojson.Unmarshal(body, &graph))
+	require.Len(t, graph.GetNodes(), 2)
+	assert.Equal(t, uint32(0), graph.GetNodes()[0].GetDepth())
+	assert.Equal(t, "CFBMEMZOIDEXQAUXYYSZIURADQLAPWPMNJXQSNVQZAHYVOPYUKKJBJUCTVJL", graph.GetNodes()[1].GetIdentity())
+	assert.Equal(t, uint32(1), graph.GetNodes()[1].GetDepth())
+	assert.Len(t, graph.GetEdges(), 2) // edge of the second node to a visited node is kept
+	assert.False(t, graph.GetTruncated())
+}
+
+func TestServer_TraceFunds_givenInvalidRequest_thenBadRequest(t *testing.T) {
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/entities/BLAH/trace", http.StatusBadRequest)
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/trace?max_depth=6", http.StatusBadRequest)
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/trace?max_fan_out=51", http.StatusBadRequest)
+	callServiceVerifyStatus(t, "http://localhost:8080/api/v1/entities/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB/trace?from_tick=2&to_tick=1", http.StatusBadRequest)
+}
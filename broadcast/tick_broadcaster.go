@@ -0,0 +1,55 @@
+package broadcast
+
+import (
+	"sync"
+)
+
+// TickBroadcaster notifies subscribers about processed ticks. Notifications are coalesced: slow subscribers only
+// receive the latest tick and have to catch up on the ticks in between themselves.
+type TickBroadcaster struct {
+	mutex       sync.Mutex
+	subscribers map[chan uint32]struct{}
+}
+
+func NewTickBroadcaster() *TickBroadcaster {
+	return &TickBroadcaster{
+		subscribers: make(map[chan uint32]struct{}),
+	}
+}
+
+// Subscribe returns a channel that receives the latest processed tick and a function to cancel the subscription.
+func (b *TickBroadcaster) Subscribe() (<-chan uint32, func()) {
+	ticks := make(chan uint32, 1)
+	b.mutex.Lock()
+	b.subscribers[ticks] = struct{}{}
+	b.mutex.Unlock()
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			b.mutex.Lock()
+			delete(b.subscribers, ticks)
+			b.mutex.Unlock()
+		})
+	}
+	return ticks, cancel
+}
+
+// Publish notifies all subscribers about the tick without blocking.
+func (b *TickBroadcaster) Publish(tick uint32) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	for ticks := range b.subscribers {
+		select { // drop not yet received tick
+		case <-ticks:
+		default:
+		}
+		ticks <- tick // cannot block. buffer is empty and only written with the lock held.
+	}
+}
+
+func (b *TickBroadcaster) SubscriberCount() int {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return len(b.subscribers)
+}
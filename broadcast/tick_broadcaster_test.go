@@ -0,0 +1,38 @@
+package broadcast
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTickBroadcaster_Publish(t *testing.T) {
+	broadcaster := NewTickBroadcaster()
+	first, cancelFirst := broadcaster.Subscribe()
+	second, cancelSecond := broadcaster.Subscribe()
+	defer cancelSecond()
+	assert.Equal(t, 2, broadcaster.SubscriberCount())
+
+	broadcaster.Publish(1)
+	assert.Equal(t, uint32(1), <-first)
+	assert.Equal(t, uint32(1), <-second)
+
+	cancelFirst()
+	cancelFirst() // ignored
+	assert.Equal(t, 1, broadcaster.SubscriberCount())
+	broadcaster.Publish(2)
+	assert.Equal(t, uint32(2), <-second)
+	assert.Empty(t, first)
+}
+
+func TestTickBroadcaster_GivenSlowSubscriber_ThenCoalesce(t *testing.T) {
+	broadcaster := NewTickBroadcaster()
+	ticks, cancel := broadcaster.Subscribe()
+	defer cancel()
+
+	broadcaster.Publish(1)
+	broadcaster.Publish(2)
+	broadcaster.Publish(3)
+	assert.Equal(t, uint32(3), <-ticks)
+	assert.Empty(t, ticks)
+}
@@ -0,0 +1,166 @@
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gookit/slog"
+	"github.com/pkg/errors"
+)
+
+// Reloader keeps a certificate key pair and a ca pool loaded from files. The files are reloaded, if they change, so
+// that renewed certificates are used without restart.
+type Reloader struct {
+	certFile    string
+	keyFile     string
+	caFile      string
+	mutex       sync.RWMutex
+	certificate *tls.Certificate // nil, if no certificate is configured
+	pool        *x509.CertPool   // nil, if no ca is configured
+	modTimes    map[string]time.Time
+}
+
+// NewReloader loads the files. Each file is optional, but certificate and key need to be set together.
+func NewReloader(certFile, keyFile, caFile string) (*Reloader, error) {
+	if (certFile == "") != (keyFile == "") {
+		return nil, errors.New("certificate and key file need to be set together")
+	}
+	r := &Reloader{certFile: certFile, keyFile: keyFile, caFile: caFile}
+	_, err := r.Reload()
+	if err != nil {
+		return nil, errors.Wrap(err, "loading certificates")
+	}
+	return r, nil
+}
+
+func (r *Reloader) ReloadInLoop(interval time.Duration) {
+	loopTick := time.Tick(interval)
+	for range loopTick {
+		reloaded, err := r.Reload()
+		if err != nil {
+			slog.Error("reloading certificates", "err", err.Error())
+		} else if reloaded {
+			slog.Info("Reloaded certificates.")
+		}
+	}
+}
+
+// Reload loads the files, if any of them changed. On error the previously loaded files are kept.
+func (r *Reloader) Reload() (bool, error) {
+	modTimes := map[string]time.Time{}
+	for _, file := range []string{r.certFile, r.keyFile, r.caFile} {
+		if file == "" {
+			continue
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			return false, errors.Wrapf(err, "reading [%s]", file)
+		}
+		modTimes[file] = info.ModTime()
+	}
+	r.mutex.RLock()
+	changed := len(modTimes) != len(r.modTimes)
+	for file, modTime := range modTimes {
+		changed = changed || !r.modTimes[file].Equal(modTime)
+	}
+	r.mutex.RUnlock()
+	if !changed {
+		return false, nil
+	}
+
+	var certificate *tls.Certificate
+	if r.certFile != "" {
+		keyPair, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+		if err != nil {
+			return false, errors.Wrap(err, "loading key pair")
+		}
+		certificate = &keyPair
+	}
+	var pool *x509.CertPool
+	if r.caFile != "" {
+		pem, err := os.ReadFile(r.caFile)
+		if err != nil {
+			return false, errors.Wrapf(err, "reading [%s]", r.caFile)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return false, errors.Errorf("no certificates found in [%s]", r.caFile)
+		}
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.certificate, r.pool, r.modTimes = certificate, pool, modTimes
+	return true, nil
+}
+
+func (r *Reloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.certificate, r.pool
+}
+
+// ServerConfig returns the tls config for servers. If a ca is configured, clients need a certificate signed by it.
+func (r *Reloader) ServerConfig() *tls.Config {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+			certificate, _ := r.current()
+			if certificate == nil {
+				return nil, errors.New("no server certificate")
+			}
+			return certificate, nil
+		},
+	}
+	if r.caFile != "" {
+		// the ca pool may change. verification is done in VerifyConnection with the current pool.
+		config.ClientAuth = tls.RequireAnyClientCert
+		config.VerifyConnection = func(state tls.ConnectionState) error {
+			_, pool := r.current()
+			return errors.Wrap(verify(state, "", pool, x509.ExtKeyUsageClientAuth), "verifying client certificate")
+		}
+	}
+	return config
+}
+
+// ClientConfig returns the tls config for clients. The server certificate is verified against the ca, or the system
+// roots, if no ca is configured. The certificate, if configured, is sent to servers, that request one.
+func (r *Reloader) ClientConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// the ca pool may change. verification is done in VerifyConnection with the current pool.
+		InsecureSkipVerify: true,
+		VerifyConnection: func(state tls.ConnectionState) error {
+			_, pool := r.current()
+			return errors.Wrap(verify(state, state.ServerName, pool, x509.ExtKeyUsageServerAuth), "verifying server certificate")
+		},
+		GetClientCertificate: func(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			certificate, _ := r.current()
+			if certificate == nil {
+				return &tls.Certificate{}, nil // no certificate is sent
+			}
+			return certificate, nil
+		},
+	}
+}
+
+// verify checks the peer certificate chain against the pool. Uses the system roots, if the pool is nil.
+func verify(state tls.ConnectionState, dnsName string, pool *x509.CertPool, usage x509.ExtKeyUsage) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("no peer certificate")
+	}
+	intermediates := x509.NewCertPool()
+	for _, certificate := range state.PeerCertificates[1:] {
+		intermediates.AddCert(certificate)
+	}
+	_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+		DNSName:       dnsName,
+		Roots:         pool,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{usage},
+	})
+	return err
+}
@@ -0,0 +1,174 @@
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCertificate struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	certFile    string
+	keyFile     string
+}
+
+// createCertificate writes a certificate signed by the parent (self signed, if parent is nil) to the directory.
+func createCertificate(t *testing.T, dir, name string, serial int64, usage x509.ExtKeyUsage, parent *testCertificate) *testCertificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+	} else {
+		signer, signerKey = parent.certificate, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	result := &testCertificate{
+		certificate: certificate,
+		key:         key,
+		certFile:    filepath.Join(dir, name+".crt"),
+		keyFile:     filepath.Join(dir, name+".key"),
+	}
+	require.NoError(t, os.WriteFile(result.certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(result.keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return result
+}
+
+// handshake connects a client to a server and returns the serial number of the server certificate.
+func handshake(t *testing.T, serverConfig, clientConfig *tls.Config) (int64, error) {
+	listener, err := tls.Listen("tcp", "localhost:0", serverConfig)
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err == nil {
+			_ = conn.(*tls.Conn).Handshake()
+			_ = conn.Close()
+		}
+	}()
+
+	dialer := &net.Dialer{Timeout: time.Second}
+	conn, err := tls.DialWithDialer(dialer, "tcp", listener.Addr().String(), withServerName(clientConfig))
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	// with tls 1.3 the client learns about a rejected certificate on the first read
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	if _, err := conn.Read(make([]byte, 1)); err != io.EOF {
+		return 0, err
+	}
+	return conn.ConnectionState().PeerCertificates[0].SerialNumber.Int64(), nil
+}
+
+func withServerName(config *tls.Config) *tls.Config {
+	config = config.Clone()
+	config.ServerName = "localhost"
+	return config
+}
+
+func TestReloader_givenMutualTls_thenVerifyBothSides(t *testing.T) {
+	dir := t.TempDir()
+	ca := createCertificate(t, dir, "ca", 1, x509.ExtKeyUsageAny, nil)
+	server := createCertificate(t, dir, "server", 2, x509.ExtKeyUsageServerAuth, ca)
+	client := createCertificate(t, dir, "client", 3, x509.ExtKeyUsageClientAuth, ca)
+
+	serverReloader, err := NewReloader(server.certFile, server.keyFile, ca.certFile)
+	require.NoError(t, err)
+	clientReloader, err := NewReloader(client.certFile, client.keyFile, ca.certFile)
+	require.NoError(t, err)
+
+	serial, err := handshake(t, serverReloader.ServerConfig(), clientReloader.ClientConfig())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), serial)
+
+	anonymousReloader, err := NewReloader("", "", ca.certFile)
+	require.NoError(t, err)
+	_, err = handshake(t, serverReloader.ServerConfig(), anonymousReloader.ClientConfig())
+	assert.Error(t, err, "client certificate required")
+
+	otherDir := t.TempDir()
+	otherCa := createCertificate(t, otherDir, "ca", 4, x509.ExtKeyUsageAny, nil)
+	untrustingReloader, err := NewReloader(client.certFile, client.keyFile, otherCa.certFile)
+	require.NoError(t, err)
+	_, err = handshake(t, serverReloader.ServerConfig(), untrustingReloader.ClientConfig())
+	assert.ErrorContains(t, err, "verifying server certificate")
+}
+
+func TestReloader_Reload_givenChangedFiles_thenUseNewCertificate(t *testing.T) {
+	dir := t.TempDir()
+	ca := createCertificate(t, dir, "ca", 1, x509.ExtKeyUsageAny, nil)
+	server := createCertificate(t, dir, "server", 2, x509.ExtKeyUsageServerAuth, ca)
+	serverReloader, err := NewReloader(server.certFile, server.keyFile, "")
+	require.NoError(t, err)
+	clientReloader, err := NewReloader("", "", ca.certFile)
+	require.NoError(t, err)
+
+	reloaded, err := serverReloader.Reload()
+	require.NoError(t, err)
+	assert.False(t, reloaded)
+
+	createCertificate(t, dir, "server", 5, x509.ExtKeyUsageServerAuth, ca) // renewed
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(server.certFile, later, later))
+	reloaded, err = serverReloader.Reload()
+	require.NoError(t, err)
+	assert.True(t, reloaded)
+
+	serial, err := handshake(t, serverReloader.ServerConfig(), clientReloader.ClientConfig())
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), serial)
+}
+
+func TestReloader_Reload_givenInvalidFiles_thenKeepCertificate(t *testing.T) {
+	dir := t.TempDir()
+	server := createCertificate(t, dir, "server", 2, x509.ExtKeyUsageServerAuth, nil)
+	reloader, err := NewReloader(server.certFile, server.keyFile, "")
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(server.certFile, []byte("invalid"), 0600))
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(server.certFile, later, later))
+	_, err = reloader.Reload()
+	assert.Error(t, err)
+
+	certificate, _ := reloader.current()
+	require.NotNil(t, certificate)
+	assert.Equal(t, int64(2), certificate.Leaf.SerialNumber.Int64())
+}
+
+func TestNewReloader_givenCertificateWithoutKey_thenError(t *testing.T) {
+	_, err := NewReloader("server.crt", "", "")
+	assert.Error(t, err)
+}
@@ -2,11 +2,14 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
 	"github.com/pkg/errors"
 	eventspb "github.com/qubic/go-events/proto"
 	qubicpb "github.com/qubic/go-qubic/proto/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"time"
 )
 
 type IntegrationEventClient struct {
@@ -19,16 +22,27 @@ type TickInfo struct {
 	InitialTick uint32
 }
 
+type TickData struct {
+	Timestamp time.Time
+	Epoch     uint32
+}
+
 type EventStatus struct {
 	AvailableTick uint32
 }
 
-func NewIntegrationEventClient(eventApiUrl, coreApiUrl string) (*IntegrationEventClient, error) {
-	eventApiConn, err := grpc.NewClient(eventApiUrl, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// NewIntegrationEventClient creates the clients for the event and the core api. If tlsConfig is nil, the connections
+// are not encrypted.
+func NewIntegrationEventClient(eventApiUrl, coreApiUrl string, tlsConfig *tls.Config) (*IntegrationEventClient, error) {
+	transportCredentials := insecure.NewCredentials()
+	if tlsConfig != nil {
+		transportCredentials = credentials.NewTLS(tlsConfig)
+	}
+	eventApiConn, err := grpc.NewClient(eventApiUrl, grpc.WithTransportCredentials(transportCredentials))
 	if err != nil {
 		return nil, errors.Wrap(err, "creating event api connection")
 	}
-	coreApiConn, err := grpc.NewClient(coreApiUrl, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	coreApiConn, err := grpc.NewClient(coreApiUrl, grpc.WithTransportCredentials(transportCredentials))
 	if err != nil {
 		return nil, errors.Wrap(err, "creating core api connection")
 	}
@@ -65,3 +79,15 @@ func (eventClient *IntegrationEventClient) GetTickInfo(context context.Context)
 	}
 	return &tiDto, nil
 }
+
+func (eventClient *IntegrationEventClient) GetTickData(context context.Context, tickNumber uint32) (*TickData, error) {
+	td, err := eventClient.coreApi.GetTickData(context, &qubicpb.GetTickDataRequest{Tick: tickNumber})
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting tick data for tick [%d]", tickNumber)
+	}
+	tdDto := TickData{Epoch: td.GetEpoch()}
+	if td.GetTimestamp() != nil { // empty ticks have no timestamp
+		tdDto.Timestamp = td.GetTimestamp().AsTime()
+	}
+	return &tdDto, nil
+}
@@ -39,6 +39,14 @@ func TestEventClient_GetTickInfo(t *testing.T) {
 	slog.Info("Received tick info", "tick info", info)
 }
 
+func TestEventClient_GetTickData(t *testing.T) {
+	const tickNumber uint32 = 19236443 // needs current tick number
+	tickData, err := eventClient.GetTickData(context.Background(), tickNumber)
+	assert.Nil(t, err)
+	assert.False(t, tickData.Timestamp.IsZero())
+	slog.Info("Received tick data", "tick data", tickData)
+}
+
 func TestMain(m *testing.M) {
 	// slog.SetLogLoggerLevel(slog.LevelDebug)
 	setup()
@@ -66,7 +74,7 @@ func setup() {
 		slog.Error("error getting config", "err", err)
 		os.Exit(-1)
 	}
-	eventClient, err = NewIntegrationEventClient(config.Client.EventApiUrl, config.Client.CoreApiUrl)
+	eventClient, err = NewIntegrationEventClient(config.Client.EventApiUrl, config.Client.CoreApiUrl, nil)
 	if err != nil {
 		slog.Error("error creating event client", "err", err)
 		os.Exit(-1)
@@ -0,0 +1,177 @@
+package transfers
+
+import (
+	"context"
+	"crypto/tls"
+	"go-transfers/proto"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	apiKeyHeader       = "x-api-key"
+	defaultTimeout     = 30 * time.Second
+	defaultMaxAttempts = 3
+	defaultBackoff     = 200 * time.Millisecond
+)
+
+// Client calls the transfer service. Failed calls are retried, if the service is unavailable, and errors are returned
+// as *Error.
+type Client struct {
+	conn    *grpc.ClientConn
+	service proto.TransferServiceClient
+	options options
+}
+
+type options struct {
+	timeout     time.Duration
+	maxAttempts int
+	backoff     time.Duration
+	tlsConfig   *tls.Config
+	apiKey      string
+	dialOptions []grpc.DialOption
+}
+
+type Option func(*options)
+
+// WithTimeout sets the timeout of every call attempt, if the context has no earlier deadline. 0 means no timeout.
+// Defaults to 30 seconds.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.timeout = timeout
+	}
+}
+
+// WithRetries sets the max number of attempts for calls, that fail because the service is unavailable, and the
+// delay before the first retry. The delay doubles with every retry. Defaults to 3 attempts and 200 milliseconds.
+func WithRetries(maxAttempts int, backoff time.Duration) Option {
+	return func(o *options) {
+		o.maxAttempts, o.backoff = max(maxAttempts, 1), backoff
+	}
+}
+
+// WithTLS encrypts the connection. Without it the connection is plaintext.
+func WithTLS(tlsConfig *tls.Config) Option {
+	return func(o *options) {
+		o.tlsConfig = tlsConfig
+	}
+}
+
+// WithApiKey sends the api key with every call.
+func WithApiKey(apiKey string) Option {
+	return func(o *options) {
+		o.apiKey = apiKey
+	}
+}
+
+// WithDialOptions adds grpc dial options, for example for custom dialers or interceptors.
+func WithDialOptions(dialOptions ...grpc.DialOption) Option {
+	return func(o *options) {
+		o.dialOptions = append(o.dialOptions, dialOptions...)
+	}
+}
+
+// New creates a client for the grpc endpoint of the transfer service, for example localhost:8081. The connection is
+// established on the first call.
+func New(target string, opts ...Option) (*Client, error) {
+	c := &Client{options: options{timeout: defaultTimeout, maxAttempts: defaultMaxAttempts, backoff: defaultBackoff}}
+	for _, opt := range opts {
+		opt(&c.options)
+	}
+	transportCredentials := insecure.NewCredentials()
+	if c.options.tlsConfig != nil {
+		transportCredentials = credentials.NewTLS(c.options.tlsConfig)
+	}
+	dialOptions := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(transportCredentials),
+		grpc.WithChainUnaryInterceptor(c.unaryInterceptor),
+		grpc.WithChainStreamInterceptor(c.streamInterceptor),
+	}, c.options.dialOptions...)
+	conn, err := grpc.NewClient(target, dialOptions...)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating transfer service connection")
+	}
+	c.conn, c.service = conn, proto.NewTransferServiceClient(conn)
+	return c, nil
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Service returns the generated client for calls without helper method. Retries, timeouts and errors are handled
+// the same way.
+func (c *Client) Service() proto.TransferServiceClient {
+	return c.service
+}
+
+func (c *Client) unaryInterceptor(ctx context.Context, method string, request, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	ctx = c.withApiKey(ctx)
+	backoff := c.options.backoff
+	for attempt := 1; ; attempt++ {
+		err := c.invoke(ctx, method, request, reply, cc, invoker, opts...)
+		if status.Code(err) != codes.Unavailable || attempt >= c.options.maxAttempts {
+			return toError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return toError(err)
+		case <-time.After(backoff):
+			backoff *= 2
+		}
+	}
+}
+
+func (c *Client) invoke(ctx context.Context, method string, request, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if c.options.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.options.timeout)
+		defer cancel()
+	}
+	return invoker(ctx, method, request, reply, cc, opts...)
+}
+
+// streamInterceptor adds the api key. Streams are neither retried nor limited in time.
+func (c *Client) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	stream, err := streamer(c.withApiKey(ctx), desc, cc, method, opts...)
+	return stream, toError(err)
+}
+
+func (c *Client) withApiKey(ctx context.Context) context.Context {
+	if c.options.apiKey == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, apiKeyHeader, c.options.apiKey)
+}
+
+func (c *Client) GetQuTransfersForTick(ctx context.Context, tick uint32) (*proto.QuTransferEventsResponse, error) {
+	return c.service.GetQuTransferEventsForTick(ctx, &proto.TickRequest{Tick: tick})
+}
+
+func (c *Client) GetAssetChangesForTick(ctx context.Context, tick uint32) (*proto.AssetChangeEventsResponse, error) {
+	return c.service.GetAssetChangeEventsForTick(ctx, &proto.TickRequest{Tick: tick})
+}
+
+func (c *Client) GetTransfersForTransaction(ctx context.Context, hash string) (*proto.TransactionEventsResponse, error) {
+	return c.service.GetTransfersForTransaction(ctx, &proto.TransactionRequest{Hash: hash})
+}
+
+func (c *Client) GetAssetHoldings(ctx context.Context, identity string) (*proto.AssetHoldingsResponse, error) {
+	return c.service.GetAssetHoldingsForEntity(ctx, &proto.HoldingsRequest{Identity: identity})
+}
+
+func (c *Client) GetAssetHolders(ctx context.Context, issuer, name string) (*proto.AssetHoldersResponse, error) {
+	return c.service.GetAssetHolders(ctx, &proto.AssetRequest{Issuer: issuer, Name: name})
+}
+
+func (c *Client) GetAssets(ctx context.Context) (*proto.AssetsResponse, error) {
+	return c.service.GetAssets(ctx, &emptypb.Empty{})
+}
@@ -0,0 +1,170 @@
+package transfers
+
+import (
+	"context"
+	"go-transfers/proto"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type FakeTransferService struct {
+	proto.UnimplementedTransferServiceServer
+	unavailable int // number of calls to fail
+	calls       int
+	apiKey      string
+	delay       time.Duration
+}
+
+func (f *FakeTransferService) GetQuTransferEventsForTick(ctx context.Context, request *proto.TickRequest) (*proto.QuTransferEventsResponse, error) {
+	f.calls++
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(apiKeyHeader)) > 0 {
+		f.apiKey = md.Get(apiKeyHeader)[0]
+	}
+	if f.calls <= f.unavailable {
+		return nil, status.Error(codes.Unavailable, "try again")
+	}
+	select {
+	case <-time.After(f.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if request.GetTick() > 1000 {
+		s, _ := status.New(codes.NotFound, "tick not processed").WithDetails(&errdetails.ErrorInfo{
+			Reason:   "TICK_NOT_YET_PROCESSED",
+			Metadata: map[string]string{"latestTick": "1000"},
+		})
+		return nil, s.Err()
+	}
+	return &proto.QuTransferEventsResponse{LatestTick: 1000}, nil
+}
+
+// GetQuTransferEventsForEntity returns 25 events in pages of page size.
+func (f *FakeTransferService) GetQuTransferEventsForEntity(_ context.Context, request *proto.EntityRequest) (*proto.QuTransferEventsResponse, error) {
+	f.calls++
+	start := 0
+	if request.GetPageToken() != "" {
+		start, _ = strconv.Atoi(request.GetPageToken())
+	}
+	end := min(start+int(request.GetPageSize()), 25)
+	response := &proto.QuTransferEventsResponse{}
+	for i := start; i < end; i++ {
+		response.Events = append(response.Events, &proto.QuTransferEvent{EventId: uint64(i)})
+	}
+	if end < 25 {
+		response.NextPageToken = strconv.Itoa(end)
+	}
+	return response, nil
+}
+
+func (f *FakeTransferService) GetAssetChangeEventsForEntity(_ context.Context, _ *proto.EntityRequest) (*proto.AssetChangeEventsResponse, error) {
+	s, _ := status.New(codes.ResourceExhausted, "rate limit exceeded").WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Second)})
+	return nil, s.Err()
+}
+
+func startFakeService(t *testing.T, service *FakeTransferService, opts ...Option) *Client {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	proto.RegisterTransferServiceServer(server, service)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	opts = append([]Option{
+		WithRetries(3, time.Millisecond),
+		WithDialOptions(grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		})),
+	}, opts...)
+	c, err := New("passthrough:///bufnet", opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = c.Close() })
+	return c
+}
+
+func TestClient_givenUnavailable_thenRetry(t *testing.T) {
+	service := &FakeTransferService{unavailable: 2}
+	c := startFakeService(t, service, WithApiKey("qtr_test"))
+
+	response, err := c.GetQuTransfersForTick(context.Background(), 1000)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1000), response.GetLatestTick())
+	assert.Equal(t, 3, service.calls)
+	assert.Equal(t, "qtr_test", service.apiKey)
+}
+
+func TestClient_givenUnavailableTooOften_thenError(t *testing.T) {
+	service := &FakeTransferService{unavailable: 3}
+	c := startFakeService(t, service)
+
+	_, err := c.GetQuTransfersForTick(context.Background(), 1000)
+	assert.ErrorIs(t, err, ErrUnavailable)
+	assert.Equal(t, 3, service.calls)
+}
+
+func TestClient_givenErrorDetails_thenTypedError(t *testing.T) {
+	c := startFakeService(t, &FakeTransferService{})
+
+	_, err := c.GetQuTransfersForTick(context.Background(), 1001)
+	assert.ErrorIs(t, err, ErrNotFound)
+	var serviceError *Error
+	require.ErrorAs(t, err, &serviceError)
+	assert.Equal(t, "TICK_NOT_YET_PROCESSED", serviceError.Reason)
+	assert.Equal(t, "1000", serviceError.Metadata["latestTick"])
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = c.Service().GetAssetChangeEventsForEntity(context.Background(), &proto.EntityRequest{})
+	assert.ErrorIs(t, err, ErrRateLimited)
+	require.ErrorAs(t, err, &serviceError)
+	assert.Equal(t, time.Second, serviceError.RetryDelay)
+}
+
+func TestClient_givenSlowResponse_thenTimeout(t *testing.T) {
+	c := startFakeService(t, &FakeTransferService{delay: time.Second}, WithTimeout(10*time.Millisecond))
+
+	_, err := c.GetQuTransfersForTick(context.Background(), 1000)
+	assert.ErrorIs(t, err, ErrTimeout)
+}
+
+func TestClient_QuTransfersForEntity_thenFollowPages(t *testing.T) {
+	service := &FakeTransferService{}
+	c := startFakeService(t, service)
+
+	var eventIds []uint64
+	for event, err := range c.QuTransfersForEntity(context.Background(), &proto.EntityRequest{PageSize: 10}) {
+		require.NoError(t, err)
+		eventIds = append(eventIds, event.GetEventId())
+	}
+	assert.Len(t, eventIds, 25)
+	assert.Equal(t, uint64(24), eventIds[24])
+	assert.Equal(t, 3, service.calls)
+
+	service.calls = 0
+	for event := range c.QuTransfersForEntity(context.Background(), &proto.EntityRequest{PageSize: 10}) {
+		if event.GetEventId() == 4 {
+			break
+		}
+	}
+	assert.Equal(t, 1, service.calls, "stop requesting pages")
+}
+
+func TestClient_AssetChangesForEntity_givenError_thenYieldError(t *testing.T) {
+	c := startFakeService(t, &FakeTransferService{})
+
+	var errs []error
+	for _, err := range c.AssetChangesForEntity(context.Background(), &proto.EntityRequest{}) {
+		errs = append(errs, err)
+	}
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], ErrRateLimited)
+}
@@ -0,0 +1,93 @@
+package transfers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errors to check with errors.Is. The returned errors are of type *Error with the details of the service.
+var (
+	ErrInvalidArgument  = errors.New("invalid argument")
+	ErrNotFound         = errors.New("not found")
+	ErrUnauthenticated  = errors.New("unauthenticated")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrRateLimited      = errors.New("rate limited")
+	ErrUnavailable      = errors.New("unavailable")
+	ErrTimeout          = errors.New("timeout")
+	ErrInternal         = errors.New("internal error")
+)
+
+var errorsByCode = map[codes.Code]error{
+	codes.InvalidArgument:   ErrInvalidArgument,
+	codes.OutOfRange:        ErrInvalidArgument,
+	codes.NotFound:          ErrNotFound,
+	codes.Unauthenticated:   ErrUnauthenticated,
+	codes.PermissionDenied:  ErrPermissionDenied,
+	codes.ResourceExhausted: ErrRateLimited,
+	codes.Unavailable:       ErrUnavailable,
+	codes.DeadlineExceeded:  ErrTimeout,
+	codes.Internal:          ErrInternal,
+	codes.Unknown:           ErrInternal,
+}
+
+// Error is an error returned by the transfer service.
+type Error struct {
+	Code       codes.Code
+	Message    string
+	Reason     string            // machine-readable reason, for example TICK_NOT_YET_PROCESSED. empty, if not set.
+	Metadata   map[string]string // details of the reason, for example the latest processed tick
+	Field      string            // invalid request field. empty, if not set.
+	RetryDelay time.Duration     // when to retry rate limited requests. 0, if not set.
+	status     *status.Status
+}
+
+func (e *Error) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("transfer service: %s (%s): %s", e.Code, e.Reason, e.Message)
+	}
+	return fmt.Sprintf("transfer service: %s: %s", e.Code, e.Message)
+}
+
+// Is matches the error variable of the status code.
+func (e *Error) Is(target error) bool {
+	return errorsByCode[e.Code] == target
+}
+
+// GRPCStatus returns the original status, so that status.FromError and status.Code work on the error.
+func (e *Error) GRPCStatus() *status.Status {
+	return e.status
+}
+
+// toError converts the status error of a call. Other errors are returned unchanged.
+func toError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		err = status.FromContextError(err).Err()
+	}
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	result := &Error{Code: s.Code(), Message: s.Message(), status: s}
+	for _, detail := range s.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			result.Reason, result.Metadata = d.GetReason(), d.GetMetadata()
+		case *errdetails.BadRequest:
+			if len(d.GetFieldViolations()) > 0 {
+				result.Field = d.GetFieldViolations()[0].GetField()
+			}
+		case *errdetails.RetryInfo:
+			result.RetryDelay = d.GetRetryDelay().AsDuration()
+		}
+	}
+	return result
+}
@@ -0,0 +1,64 @@
+package transfers
+
+import (
+	"context"
+	"go-transfers/proto"
+	"iter"
+
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// QuTransfersForEntity iterates over the qu transfers of the entity, latest first, and requests the next page when
+// needed. Iteration starts at the page token of the request and ends after the first error.
+func (c *Client) QuTransfersForEntity(ctx context.Context, request *proto.EntityRequest) iter.Seq2[*proto.QuTransferEvent, error] {
+	return paginate(ctx, request.GetPageToken(), func(ctx context.Context, token string) ([]*proto.QuTransferEvent, string, error) {
+		page := protobuf.Clone(request).(*proto.EntityRequest)
+		page.PageToken = token
+		response, err := c.service.GetQuTransferEventsForEntity(ctx, page)
+		return response.GetEvents(), response.GetNextPageToken(), err
+	})
+}
+
+// AssetChangesForEntity iterates over the asset changes of the entity, latest first. See QuTransfersForEntity.
+func (c *Client) AssetChangesForEntity(ctx context.Context, request *proto.EntityRequest) iter.Seq2[*proto.AssetChangeEvent, error] {
+	return paginate(ctx, request.GetPageToken(), func(ctx context.Context, token string) ([]*proto.AssetChangeEvent, string, error) {
+		page := protobuf.Clone(request).(*proto.EntityRequest)
+		page.PageToken = token
+		response, err := c.service.GetAssetChangeEventsForEntity(ctx, page)
+		return response.GetEvents(), response.GetNextPageToken(), err
+	})
+}
+
+// AssetChangesForAsset iterates over the changes of the asset, latest first. See QuTransfersForEntity.
+func (c *Client) AssetChangesForAsset(ctx context.Context, request *proto.AssetEventsRequest) iter.Seq2[*proto.AssetChangeEvent, error] {
+	return paginate(ctx, request.GetPageToken(), func(ctx context.Context, token string) ([]*proto.AssetChangeEvent, string, error) {
+		page := protobuf.Clone(request).(*proto.AssetEventsRequest)
+		page.PageToken = token
+		response, err := c.service.GetAssetChangeEventsForAsset(ctx, page)
+		return response.GetEvents(), response.GetNextPageToken(), err
+	})
+}
+
+// paginate yields the events of all pages. fetch returns the events of the page and the token of the next page, that
+// is empty for the last page.
+func paginate[T any](ctx context.Context, token string, fetch func(ctx context.Context, token string) ([]T, string, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			events, nextToken, err := fetch(ctx, token)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, event := range events {
+				if !yield(event, nil) {
+					return
+				}
+			}
+			if nextToken == "" {
+				return
+			}
+			token = nextToken
+		}
+	}
+}
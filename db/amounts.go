@@ -0,0 +1,54 @@
+package db
+
+import (
+	"go-transfers/proto"
+	"strconv"
+	"strings"
+)
+
+// FormatAmount returns the raw integer amount as decimal string with the given number of decimals, for example
+// 12345 with 2 decimals is 123.45.
+func FormatAmount(amount uint64, decimals uint32) string {
+	digits := strconv.FormatUint(amount, 10)
+	if decimals == 0 {
+		return digits
+	}
+	if len(digits) <= int(decimals) {
+		digits = strings.Repeat("0", int(decimals)-len(digits)+1) + digits
+	}
+	point := len(digits) - int(decimals)
+	return digits[:point] + "." + digits[point:]
+}
+
+// Delta is a signed change of a balance or holding. The amount uses the full uint64 range, so the sign is kept
+// separately.
+type Delta struct {
+	Amount   uint64
+	Negative bool
+}
+
+func Credit(amount uint64) Delta {
+	return Delta{Amount: amount}
+}
+
+func Debit(amount uint64) Delta {
+	return Delta{Amount: amount, Negative: true}
+}
+
+// numeric returns the delta as decimal string for numeric columns.
+func (d Delta) numeric() string {
+	if d.Negative && d.Amount > 0 {
+		return "-" + strconv.FormatUint(d.Amount, 10)
+	}
+	return strconv.FormatUint(d.Amount, 10)
+}
+
+func formatAssetChangeEvents(events []*proto.AssetChangeEvent) {
+	for _, event := range events {
+		formatAssetChangeEvent(event)
+	}
+}
+
+func formatAssetChangeEvent(event *proto.AssetChangeEvent) {
+	event.FormattedNumberOfShares = FormatAmount(event.GetNumberOfShares(), event.GetNumberOfDecimals())
+}
@@ -0,0 +1,24 @@
+package db
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatAmount(t *testing.T) {
+	assert.Equal(t, "12345", FormatAmount(12345, 0))
+	assert.Equal(t, "123.45", FormatAmount(12345, 2))
+	assert.Equal(t, "0.005", FormatAmount(5, 3))
+	assert.Equal(t, "0.00", FormatAmount(0, 2))
+	assert.Equal(t, "18446744073709551615", FormatAmount(math.MaxUint64, 0))
+	assert.Equal(t, "0.0000018446744073709551615", FormatAmount(math.MaxUint64, 25))
+}
+
+func TestDelta_Numeric(t *testing.T) {
+	assert.Equal(t, "42", Credit(42).numeric())
+	assert.Equal(t, "-42", Debit(42).numeric())
+	assert.Equal(t, "0", Debit(0).numeric())
+	assert.Equal(t, "-18446744073709551615", Debit(math.MaxUint64).numeric())
+}
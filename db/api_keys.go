@@ -0,0 +1,46 @@
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+type ApiKey struct {
+	Id                int64   `db:"id"`
+	Name              string  `db:"name"`
+	KeyHash           string  `db:"key_hash"`
+	KeyPrefix         string  `db:"key_prefix"`
+	RequestsPerSecond float64 `db:"requests_per_second"` // 0 means unlimited
+	Burst             int     `db:"burst"`
+	DailyQuota        int64   `db:"daily_quota"` // 0 means unlimited
+	Enabled           bool    `db:"enabled"`
+}
+
+func (r *PgRepository) CreateApiKey(ctx context.Context, key ApiKey) (int64, error) {
+	insertSql := `insert into api_keys (name, key_hash, key_prefix, requests_per_second, burst, daily_quota)
+		values ($1, $2, $3, $4, $5, $6) returning id;`
+	var id int64
+	err := r.db.GetContext(ctx, &id, insertSql, key.Name, key.KeyHash, key.KeyPrefix, key.RequestsPerSecond, key.Burst, key.DailyQuota)
+	return id, errors.Wrap(err, "creating api key")
+}
+
+func (r *PgRepository) GetApiKeys(ctx context.Context) ([]ApiKey, error) {
+	selectSql := `select id, name, key_hash, key_prefix, requests_per_second, burst, daily_quota, enabled from api_keys order by id;`
+	var keys []ApiKey
+	err := r.db.SelectContext(ctx, &keys, selectSql)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting api keys")
+	}
+	return keys, nil
+}
+
+// DeleteApiKey deletes the api key and returns false, if the key was not found.
+func (r *PgRepository) DeleteApiKey(ctx context.Context, id int64) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `delete from api_keys where id = $1;`, id)
+	if err != nil {
+		return false, errors.Wrapf(err, "deleting api key [%d]", id)
+	}
+	count, err := result.RowsAffected()
+	return count > 0, errors.Wrapf(err, "deleting api key [%d]", id)
+}
@@ -0,0 +1,37 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPgRepository_CreateApiKey_ThenGetApiKeys(t *testing.T) {
+	key := ApiKey{
+		Name:              "exchange",
+		KeyHash:           "0123456789abcdef",
+		KeyPrefix:         "qtr_0123",
+		RequestsPerSecond: 2.5,
+		Burst:             10,
+		DailyQuota:        1000,
+	}
+	id, err := repository.CreateApiKey(context.Background(), key)
+	assert.Nil(t, err)
+
+	keys, err := repository.GetApiKeys(context.Background())
+	assert.Nil(t, err)
+	key.Id = id
+	key.Enabled = true
+	assert.Contains(t, keys, key)
+
+	_, err = repository.CreateApiKey(context.Background(), key)
+	assert.Error(t, err) // hash is unique
+
+	found, err := repository.DeleteApiKey(context.Background(), id)
+	assert.Nil(t, err)
+	assert.True(t, found)
+	found, err = repository.DeleteApiKey(context.Background(), id)
+	assert.Nil(t, err)
+	assert.False(t, found)
+}
@@ -3,6 +3,8 @@ package db
 import (
 	"context"
 	"database/sql"
+	"go-transfers/proto"
+
 	_ "github.com/lib/pq"
 	"github.com/pkg/errors"
 )
@@ -28,10 +30,53 @@ func (r *PgRepository) getAssetId(ctx context.Context, issuer, name string) (int
     join entities e on a.issuer_id = e.id
     where e.identity=$1 and a.name=$2;`
 
-	return getId(ctx, r.db, selectSql, issuer, name)
+	return getId(ctx, r.conn(ctx), selectSql, issuer, name)
 }
 
 func (r *PgRepository) insertAsset(ctx context.Context, issuerId int, name string) (int, error) {
 	insertSql := `insert into assets (issuer_id, name) values ($1, $2) returning id;`
-	return insert(ctx, r.db, insertSql, issuerId, name)
+	return insert(ctx, r.conn(ctx), insertSql, issuerId, name)
+}
+
+// UpdateAssetDecimals stores the number of decimals and the measurement unit of the asset, if they changed.
+func (r *PgRepository) UpdateAssetDecimals(ctx context.Context, assetId int, numberOfDecimals uint32, unitOfMeasurement string) error {
+	updateSql := `update assets set number_of_decimals = $2, unit_of_measurement = $3
+		where id = $1 and (number_of_decimals is distinct from $2 or unit_of_measurement is distinct from $3);`
+	_, err := r.conn(ctx).ExecContext(ctx, updateSql, assetId, numberOfDecimals, unitOfMeasurement)
+	return errors.Wrapf(err, "updating decimals of asset [%d]", assetId)
+}
+
+// getAssetDecimals returns the number of decimals of the asset. 0, if unknown.
+func (r *PgRepository) getAssetDecimals(ctx context.Context, issuer, name string) (uint32, error) {
+	selectSql := `select coalesce(a.number_of_decimals, 0) from assets a
+    join entities e on a.issuer_id = e.id
+    where e.identity=$1 and a.name=$2;`
+	var decimals uint32
+	err := r.db.GetContext(ctx, &decimals, selectSql, issuer, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return decimals, err
+}
+
+// GetAssets returns all known assets with the number of tracked ownership changes.
+func (r *PgRepository) GetAssets(ctx context.Context) ([]*proto.Asset, error) {
+	selectSql := `select issuer.identity issuerId,
+       		a.name,
+       		a.verified,
+       		count(e.id) transferCount,
+       		coalesce(a.number_of_decimals, 0) numberOfDecimals,
+       		coalesce(a.unit_of_measurement, '') unitOfMeasurement
+		from assets a
+		join entities issuer on a.issuer_id = issuer.id
+		left join asset_change_events ev on ev.asset_id = a.id
+		left join events e on ev.event_id = e.id and e.event_type = 2
+		group by issuer.identity, a.name, a.verified, a.number_of_decimals, a.unit_of_measurement
+		order by a.verified desc, issuer.identity, a.name;`
+	var assets []*proto.Asset
+	err := r.db.SelectContext(ctx, &assets, selectSql)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting assets")
+	}
+	return assets, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"github.com/stretchr/testify/assert"
+	"go-transfers/proto"
 	"testing"
 )
 
@@ -55,3 +56,29 @@ func TestPgRepository_GetOrCreateAsset_GivenNoAsset_ThenCreate(t *testing.T) {
 	// clean up
 	deleteAsset(assetId, t)
 }
+
+func TestPgRepository_GetAssets(t *testing.T) {
+	assets, err := repository.GetAssets(context.Background())
+	assert.Nil(t, err)
+	assert.Contains(t, assets, &proto.Asset{IssuerId: AAA, Name: "QX", Verified: true})
+}
+
+func TestPgRepository_UpdateAssetDecimals(t *testing.T) {
+	assetId, err := repository.GetOrCreateAsset(context.Background(), AAA, "DECIMAL")
+	assert.Nil(t, err)
+
+	err = repository.UpdateAssetDecimals(context.Background(), assetId, 2, "AQAAAAAAAA==")
+	assert.Nil(t, err)
+	err = repository.UpdateAssetDecimals(context.Background(), assetId, 2, "AQAAAAAAAA==") // unchanged
+	assert.Nil(t, err)
+
+	assets, err := repository.GetAssets(context.Background())
+	assert.Nil(t, err)
+	assert.Contains(t, assets, &proto.Asset{IssuerId: AAA, Name: "DECIMAL", NumberOfDecimals: 2, UnitOfMeasurement: "AQAAAAAAAA=="})
+	decimals, err := repository.getAssetDecimals(context.Background(), AAA, "DECIMAL")
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), decimals)
+
+	// clean up
+	deleteAsset(assetId, t)
+}
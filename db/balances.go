@@ -0,0 +1,103 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"go-transfers/proto"
+
+	_ "github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// qu balance changes (running total per entity)
+
+func (r *PgRepository) GetOrCreateQuBalanceChange(ctx context.Context, eventId, entityId int, delta Delta) (int, error) {
+	id, err := r.getQuBalanceChangeId(ctx, eventId, entityId)
+	if errors.Is(err, sql.ErrNoRows) {
+		id, err = r.insertQuBalanceChange(ctx, eventId, entityId, delta)
+	}
+	return id, errors.Wrapf(err, "getting or creating qu balance change for event [%d] and entity [%d]", eventId, entityId)
+}
+
+func (r *PgRepository) getQuBalanceChangeId(ctx context.Context, eventId, entityId int) (int, error) {
+	selectSql := `select id from entity_qu_balances where event_id = $1 and entity_id = $2;`
+	return getId(ctx, r.conn(ctx), selectSql, eventId, entityId)
+}
+
+// insertQuBalanceChange adds the delta to the balance of the preceding change of the entity in (tick, event) order and
+// to the balances of all following changes, so that changes can be inserted in any order. The entity row is locked, so
+// that concurrent changes of the same entity see each other.
+func (r *PgRepository) insertQuBalanceChange(ctx context.Context, eventId, entityId int, delta Delta) (int, error) {
+	lockSql := `select id from entities where id = $1 for update;`
+	insertSql := `insert into entity_qu_balances (event_id, entity_id, delta, balance)
+		values ($1, $2, $3::numeric, $3::numeric + coalesce((
+			select b.balance from entity_qu_balances b
+			join events e on b.event_id = e.id
+			join transactions tx on e.transaction_id = tx.id
+			join ticks ti on tx.tick_id = ti.id
+			where b.entity_id = $2
+			and (ti.tick_number, e.event_id) < (select pti.tick_number, pe.event_id from events pe
+				join transactions ptx on pe.transaction_id = ptx.id
+				join ticks pti on ptx.tick_id = pti.id
+				where pe.id = $1)
+			order by ti.tick_number desc, e.event_id desc
+			limit 1), 0))
+		returning id;`
+	updateSql := `update entity_qu_balances b set balance = b.balance + $3::numeric
+		from events e
+		join transactions tx on e.transaction_id = tx.id
+		join ticks ti on tx.tick_id = ti.id
+		where b.event_id = e.id
+		and b.entity_id = $2
+		and (ti.tick_number, e.event_id) > (select pti.tick_number, pe.event_id from events pe
+			join transactions ptx on pe.transaction_id = ptx.id
+			join ticks pti on ptx.tick_id = pti.id
+			where pe.id = $1);`
+
+	var id int
+	err := r.InTransaction(ctx, func(ctx context.Context) error {
+		tx := r.conn(ctx)
+		if _, err := tx.ExecContext(ctx, lockSql, entityId); err != nil {
+			return errors.Wrapf(err, "locking entity [%d]", entityId)
+		}
+		if err := tx.GetContext(ctx, &id, insertSql, eventId, entityId, delta.numeric()); err != nil {
+			return errors.Wrap(err, "inserting balance change")
+		}
+		_, err := tx.ExecContext(ctx, updateSql, eventId, entityId, delta.numeric())
+		return errors.Wrap(err, "updating following balances")
+	})
+	return id, err
+}
+
+// GetQuBalanceHistoryForEntity returns the balance changes of the entity per tick, latest first. The direction filters
+// the summed changes. The balance is always the running total after the tick. The cursor event id is not used.
+func (r *PgRepository) GetQuBalanceHistoryForEntity(ctx context.Context, identity string, filter EntityFilter) ([]*proto.QuBalanceChange, error) {
+	selectSql := `select ti.tick_number tick,
+       		coalesce(sum(b.delta) filter (where ($6 = 1 and b.delta > 0) or ($6 = 2 and b.delta < 0) or $6 = 0), 0)::text change,
+       		(array_agg(b.balance order by e.event_id desc))[1]::text balance,
+       		coalesce((extract(epoch from ti.timestamp) * 1000)::bigint, 0) as timestamp
+		from entity_qu_balances b
+		join entities en on b.entity_id = en.id
+		join events e on b.event_id = e.id
+		join transactions tx on e.transaction_id = tx.id
+		join ticks ti on tx.tick_id = ti.id
+		where en.identity = $1
+		and ($2::timestamptz is null or ti.timestamp >= $2)
+		and ($3::timestamptz is null or ti.timestamp < $3)
+		and ($4::bigint is null or ti.tick_number >= $4)
+		and ($5::bigint is null or ti.tick_number <= $5)
+		and ($7::bigint is null or ti.tick_number < $7)
+		group by ti.tick_number, ti.timestamp
+		having count(*) filter (where ($6 = 1 and b.delta > 0) or ($6 = 2 and b.delta < 0) or $6 = 0) > 0
+		order by ti.tick_number desc
+		limit $8;`
+	fromTick, toTick := filter.tickArgs()
+	afterTick, _ := filter.cursorArgs()
+	var changes []*proto.QuBalanceChange
+	err := r.db.SelectContext(ctx, &changes, selectSql, identity, nullTime(filter.FromTime), nullTime(filter.ToTime), fromTick, toTick,
+		filter.Direction, afterTick, filter.limitArg())
+	if err != nil {
+		return nil, errors.Wrap(err, "getting qu balance history")
+	}
+	return changes, nil
+}
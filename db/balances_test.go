@@ -0,0 +1,165 @@
+package db
+
+import (
+	"context"
+	"go-transfers/proto"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func deleteQuBalanceChange(id int, t *testing.T) {
+	count, err := repository.delete(`delete from entity_qu_balances where id = $1;`, id)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestPgRepository_GetOrCreateQuBalanceChange_GivenNone_ThenCreate(t *testing.T) {
+	tickId, transactionId, eventId := setupEventTestData(t, 0)
+	sourceEntityId, destinationEntityId := setupSourceAndDestinationEntity(t)
+
+	changeId, err := repository.GetOrCreateQuBalanceChange(context.Background(), eventId, sourceEntityId, Debit(42))
+	assert.Nil(t, err)
+	assert.Greater(t, changeId, 0)
+
+	reloaded, err := repository.GetOrCreateQuBalanceChange(context.Background(), eventId, sourceEntityId, Debit(42))
+	assert.Nil(t, err)
+	assert.Equal(t, changeId, reloaded)
+
+	largeId, err := repository.GetOrCreateQuBalanceChange(context.Background(), eventId, destinationEntityId, Credit(math.MaxUint64))
+	assert.Nil(t, err)
+	assert.Greater(t, largeId, 0)
+
+	changes, err := repository.GetQuBalanceHistoryForEntity(context.Background(), testDestinationEntity, EntityFilter{})
+	assert.Nil(t, err)
+	if assert.Len(t, changes, 1) {
+		assert.Equal(t, "18446744073709551615", changes[0].GetChange())
+		assert.Equal(t, "18446744073709551615", changes[0].GetBalance())
+	}
+
+	// clean up
+	deleteQuBalanceChange(largeId, t)
+	deleteQuBalanceChange(changeId, t)
+	cleanupEventTestData(t, transactionId, tickId, eventId)
+	deleteEntity(sourceEntityId, t)
+	deleteEntity(destinationEntityId, t)
+}
+
+func TestPgRepository_GetQuBalanceHistoryForEntity(t *testing.T) {
+	tickId, transactionId, eventId := setupEventTestData(t, 0)
+	otherEventId, err := repository.GetOrCreateEvent(context.Background(), transactionId, 2, 0, "bar")
+	assert.Nil(t, err)
+	sourceEntityId, destinationEntityId := setupSourceAndDestinationEntity(t)
+
+	change1, err := repository.GetOrCreateQuBalanceChange(context.Background(), eventId, destinationEntityId, Credit(1000))
+	assert.Nil(t, err)
+	change2, err := repository.GetOrCreateQuBalanceChange(context.Background(), otherEventId, destinationEntityId, Debit(300))
+	assert.Nil(t, err)
+
+	changes, err := repository.GetQuBalanceHistoryForEntity(context.Background(), testDestinationEntity, EntityFilter{})
+	assert.Nil(t, err)
+	assert.Equal(t, []*proto.QuBalanceChange{{
+		Tick:      testTickNumber,
+		Change:    "700",
+		Balance:   "700",
+		Timestamp: uint64(testTickTime.UnixMilli()),
+	}}, changes)
+
+	// clean up
+	deleteQuBalanceChange(change2, t)
+	deleteQuBalanceChange(change1, t)
+	deleteEvent(otherEventId, t)
+	cleanupEventTestData(t, transactionId, tickId, eventId)
+	deleteEntity(sourceEntityId, t)
+	deleteEntity(destinationEntityId, t)
+}
+
+func TestPgRepository_GetQuBalanceHistoryForEntity_givenCursor_thenReturnNextPage(t *testing.T) {
+	tickId, transactionId, eventId := setupEventTestData(t, 0)
+	nextTickId, err := repository.GetOrCreateTick(context.Background(), testTickNumber+1, testTickTime.Add(time.Second), testEpoch)
+	assert.Nil(t, err)
+	nextTransactionId, err := repository.GetOrCreateTransaction(context.Background(), "next-hash", nextTickId)
+	assert.Nil(t, err)
+	nextEventId, err := repository.GetOrCreateEvent(context.Background(), nextTransactionId, 1, 0, "bar")
+	assert.Nil(t, err)
+	sourceEntityId, destinationEntityId := setupSourceAndDestinationEntity(t)
+
+	change1, err := repository.GetOrCreateQuBalanceChange(context.Background(), eventId, destinationEntityId, Credit(1000))
+	assert.Nil(t, err)
+	change2, err := repository.GetOrCreateQuBalanceChange(context.Background(), nextEventId, destinationEntityId, Debit(300))
+	assert.Nil(t, err)
+
+	changes, err := repository.GetQuBalanceHistoryForEntity(context.Background(), testDestinationEntity, EntityFilter{Limit: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, []*proto.QuBalanceChange{{
+		Tick:      testTickNumber + 1,
+		Change:    "-300",
+		Balance:   "700",
+		Timestamp: uint64(testTickTime.Add(time.Second).UnixMilli()),
+	}}, changes)
+
+	changes, err = repository.GetQuBalanceHistoryForEntity(context.Background(), testDestinationEntity, EntityFilter{Limit: 1, After: &Cursor{Tick: testTickNumber + 1}})
+	assert.Nil(t, err)
+	assert.Equal(t, []*proto.QuBalanceChange{{
+		Tick:      testTickNumber,
+		Change:    "1000",
+		Balance:   "1000",
+		Timestamp: uint64(testTickTime.UnixMilli()),
+	}}, changes)
+
+	changes, err = repository.GetQuBalanceHistoryForEntity(context.Background(), testDestinationEntity, EntityFilter{Direction: proto.Direction_INCOMING})
+	assert.Nil(t, err)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, testTickNumber, int(changes[0].GetTick()))
+
+	// clean up
+	deleteQuBalanceChange(change2, t)
+	deleteQuBalanceChange(change1, t)
+	deleteEvent(nextEventId, t)
+	cleanUpTransactionTestData(t, nextTransactionId, nextTickId)
+	cleanupEventTestData(t, transactionId, tickId, eventId)
+	deleteEntity(sourceEntityId, t)
+	deleteEntity(destinationEntityId, t)
+}
+
+func TestPgRepository_GetOrCreateQuBalanceChange_givenEarlierChangeInsertedLater_thenUpdateFollowingBalances(t *testing.T) {
+	tickId, transactionId, eventId := setupEventTestData(t, 0)
+	nextTickId, err := repository.GetOrCreateTick(context.Background(), testTickNumber+1, testTickTime.Add(time.Second), testEpoch)
+	assert.Nil(t, err)
+	nextTransactionId, err := repository.GetOrCreateTransaction(context.Background(), "next-hash", nextTickId)
+	assert.Nil(t, err)
+	nextEventId, err := repository.GetOrCreateEvent(context.Background(), nextTransactionId, 1, 0, "bar")
+	assert.Nil(t, err)
+	sourceEntityId, destinationEntityId := setupSourceAndDestinationEntity(t)
+
+	// later tick first
+	change2, err := repository.GetOrCreateQuBalanceChange(context.Background(), nextEventId, destinationEntityId, Debit(300))
+	assert.Nil(t, err)
+	change1, err := repository.GetOrCreateQuBalanceChange(context.Background(), eventId, destinationEntityId, Credit(1000))
+	assert.Nil(t, err)
+
+	changes, err := repository.GetQuBalanceHistoryForEntity(context.Background(), testDestinationEntity, EntityFilter{})
+	assert.Nil(t, err)
+	assert.Equal(t, []*proto.QuBalanceChange{{
+		Tick:      testTickNumber + 1,
+		Change:    "-300",
+		Balance:   "700",
+		Timestamp: uint64(testTickTime.Add(time.Second).UnixMilli()),
+	}, {
+		Tick:      testTickNumber,
+		Change:    "1000",
+		Balance:   "1000",
+		Timestamp: uint64(testTickTime.UnixMilli()),
+	}}, changes)
+
+	// clean up
+	deleteQuBalanceChange(change2, t)
+	deleteQuBalanceChange(change1, t)
+	deleteEvent(nextEventId, t)
+	cleanUpTransactionTestData(t, nextTransactionId, nextTickId)
+	cleanupEventTestData(t, transactionId, tickId, eventId)
+	deleteEntity(sourceEntityId, t)
+	deleteEntity(destinationEntityId, t)
+}
@@ -0,0 +1,84 @@
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// CounterpartyVolume aggregates the transfers between an entity and one counterparty for one asset.
+type CounterpartyVolume struct {
+	Counterparty  string `db:"counterparty"`
+	Issuer        string `db:"issuer"` // empty for qu
+	Name          string `db:"name"`   // empty for qu
+	TransferCount int64  `db:"transfer_count"`
+	Incoming      string `db:"incoming"` // decimal sum, that may exceed uint64
+	Outgoing      string `db:"outgoing"` // decimal sum, that may exceed uint64
+	FirstTick     uint32 `db:"first_tick"`
+	LastTick      uint32 `db:"last_tick"`
+}
+
+// GetCounterpartiesForEntity aggregates the qu transfers and the asset ownership changes of the entity by counterparty
+// and asset. Only the counterparties with the most transfers (up to limit) are returned, ordered by number of
+// transfers. Possession changes are not counted, as they duplicate the ownership changes.
+func (r *PgRepository) GetCounterpartiesForEntity(ctx context.Context, identity string, filter EntityFilter) ([]CounterpartyVolume, error) {
+	selectSql := `with entity as (select id from entities where identity = $1),
+     		transfers as (select case when ev.source_entity_id = (select id from entity) then ev.destination_entity_id
+       					else ev.source_entity_id end counterparty_id,
+       				'' issuer,
+       				'' name,
+       				case when ev.destination_entity_id = (select id from entity) then ev.amount else 0 end incoming,
+       				case when ev.source_entity_id = (select id from entity) then ev.amount else 0 end outgoing,
+       				ti.tick_number tick
+       			from qu_transfer_events ev
+       			join events e on ev.event_id = e.id
+       			join transactions tx on e.transaction_id = tx.id
+       			join ticks ti on tx.tick_id = ti.id
+       			where e.event_type = 0
+       			and (ev.source_entity_id = (select id from entity) or ev.destination_entity_id = (select id from entity))
+       			and ($2::bigint is null or ti.tick_number >= $2)
+       			and ($3::bigint is null or ti.tick_number <= $3)
+       			union all
+       			select case when ev.source_entity_id = (select id from entity) then ev.destination_entity_id
+       					else ev.source_entity_id end,
+       				issuer.identity,
+       				a.name,
+       				case when ev.destination_entity_id = (select id from entity) then ev.number_of_shares else 0 end,
+       				case when ev.source_entity_id = (select id from entity) then ev.number_of_shares else 0 end,
+       				ti.tick_number
+       			from asset_change_events ev
+       			join events e on ev.event_id = e.id
+       			join transactions tx on e.transaction_id = tx.id
+       			join ticks ti on tx.tick_id = ti.id
+       			join assets a on ev.asset_id = a.id
+       			join entities issuer on a.issuer_id = issuer.id
+       			where e.event_type = 2
+       			and (ev.source_entity_id = (select id from entity) or ev.destination_entity_id = (select id from entity))
+       			and ($2::bigint is null or ti.tick_number >= $2)
+       			and ($3::bigint is null or ti.tick_number <= $3)),
+     		volumes as (select counterparty_id, issuer, name,
+       				count(*) transfer_count,
+       				sum(incoming)::text incoming,
+       				sum(outgoing)::text outgoing,
+       				min(tick) first_tick,
+       				max(tick) last_tick
+       			from transfers
+       			group by counterparty_id, issuer, name),
+     		top as (select counterparty_id, sum(transfer_count) total
+       			from volumes
+       			group by counterparty_id
+       			order by total desc, counterparty_id
+       			limit $4)
+		select c.identity counterparty, v.issuer, v.name, v.transfer_count, v.incoming, v.outgoing, v.first_tick, v.last_tick
+		from volumes v
+		join top on v.counterparty_id = top.counterparty_id
+		join entities c on v.counterparty_id = c.id
+		order by top.total desc, c.identity, v.issuer, v.name;`
+	fromTick, toTick := filter.tickArgs()
+	var volumes []CounterpartyVolume
+	err := r.db.SelectContext(ctx, &volumes, selectSql, identity, fromTick, toTick, filter.limitArg())
+	if err != nil {
+		return nil, errors.Wrap(err, "getting counterparties")
+	}
+	return volumes, nil
+}
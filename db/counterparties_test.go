@@ -0,0 +1,70 @@
+package db
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPgRepository_GetCounterpartiesForEntity(t *testing.T) {
+	tickId, transactionId, eventId := setupEventTestData(t, 0)
+	sourceEntityId, destinationEntityId := setupSourceAndDestinationEntity(t)
+	transferId, err := repository.GetOrCreateQuTransferEvent(context.Background(), eventId, sourceEntityId, destinationEntityId, 42)
+	assert.Nil(t, err)
+
+	volumes, err := repository.GetCounterpartiesForEntity(context.Background(), testSourceIdentity, EntityFilter{Limit: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, []CounterpartyVolume{{
+		Counterparty:  testDestinationEntity,
+		TransferCount: 1,
+		Incoming:      "0",
+		Outgoing:      "42",
+		FirstTick:     testTickNumber,
+		LastTick:      testTickNumber,
+	}}, volumes)
+
+	volumes, err = repository.GetCounterpartiesForEntity(context.Background(), testDestinationEntity, EntityFilter{Limit: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(volumes))
+	assert.Equal(t, testSourceIdentity, volumes[0].Counterparty)
+	assert.Equal(t, "42", volumes[0].Incoming)
+
+	volumes, err = repository.GetCounterpartiesForEntity(context.Background(), testSourceIdentity, EntityFilter{FromTick: testTickNumber + 1, Limit: 10})
+	assert.Nil(t, err)
+	assert.Empty(t, volumes)
+
+	deleteTransferQuEvent(transferId, t)
+	cleanupEventTestData(t, transactionId, tickId, eventId)
+	deleteEntity(sourceEntityId, t)
+	deleteEntity(destinationEntityId, t)
+}
+
+func TestPgRepository_GetCounterpartiesForEntity_givenLargeAmounts_thenSumAboveUint64(t *testing.T) {
+	tickId, transactionId, eventId := setupEventTestData(t, 0)
+	otherEventId, err := repository.GetOrCreateEvent(context.Background(), transactionId, 2, 0, "bar")
+	assert.Nil(t, err)
+	sourceEntityId, destinationEntityId := setupSourceAndDestinationEntity(t)
+	transferId, err := repository.GetOrCreateQuTransferEvent(context.Background(), eventId, sourceEntityId, destinationEntityId, math.MaxUint64)
+	assert.Nil(t, err)
+	otherTransferId, err := repository.GetOrCreateQuTransferEvent(context.Background(), otherEventId, sourceEntityId, destinationEntityId, math.MaxUint64)
+	assert.Nil(t, err)
+
+	volumes, err := repository.GetCounterpartiesForEntity(context.Background(), testSourceIdentity, EntityFilter{Limit: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(volumes))
+	assert.Equal(t, "36893488147419103230", volumes[0].Outgoing)
+
+	edges, err := repository.GetTransferEdges(context.Background(), []string{testSourceIdentity}, false, EntityFilter{}, math.MaxUint64, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(edges))
+	assert.Equal(t, "36893488147419103230", edges[0].Amount)
+
+	deleteTransferQuEvent(otherTransferId, t)
+	deleteTransferQuEvent(transferId, t)
+	deleteEvent(otherEventId, t)
+	cleanupEventTestData(t, transactionId, tickId, eventId)
+	deleteEntity(sourceEntityId, t)
+	deleteEntity(destinationEntityId, t)
+}
@@ -17,10 +17,10 @@ func (r *PgRepository) GetOrCreateEntity(ctx context.Context, identity string) (
 
 func (r *PgRepository) insertEntity(ctx context.Context, identity string) (int, error) {
 	insertSql := `insert into entities (identity) values ($1) returning id;`
-	return insert(ctx, r.db, insertSql, identity)
+	return insert(ctx, r.conn(ctx), insertSql, identity)
 }
 
 func (r *PgRepository) getEntityId(ctx context.Context, identity string) (int, error) {
 	selectSql := `select id from entities where identity= $1;`
-	return getId(ctx, r.db, selectSql, identity)
+	return getId(ctx, r.conn(ctx), selectSql, identity)
 }
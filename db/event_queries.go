@@ -2,12 +2,86 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"go-transfers/proto"
+	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 )
 
+// EntityFilter restricts the events returned for an entity. Zero values mean no restriction.
+type EntityFilter struct {
+	FromTime  time.Time // inclusive
+	ToTime    time.Time // exclusive
+	FromTick  uint32    // inclusive
+	ToTick    uint32    // inclusive
+	Direction proto.Direction
+	After     *Cursor // keyset pagination. only events after (older than) the cursor are returned.
+	Limit     int
+}
+
+// Cursor identifies an event by tick and event id for keyset pagination.
+type Cursor struct {
+	Tick    uint32
+	EventId uint64
+}
+
+// TransferFilter selects events by involved identities or assets. Events match, if any of the criteria matches.
+// An empty filter matches all events.
+type TransferFilter struct {
+	Identities []string
+	Assets     []AssetKey
+	After      *Cursor // keyset pagination. only events after (newer than) the cursor are returned.
+	Limit      int
+}
+
+type AssetKey struct {
+	Issuer string
+	Name   string
+}
+
+func (f TransferFilter) identitiesArg() pq.StringArray {
+	return append(make(pq.StringArray, 0, len(f.Identities)), f.Identities...) // nil would be null
+}
+
+func (f TransferFilter) assetArgs() (pq.StringArray, pq.StringArray) {
+	issuers := make(pq.StringArray, 0, len(f.Assets))
+	names := make(pq.StringArray, 0, len(f.Assets))
+	for _, asset := range f.Assets {
+		issuers = append(issuers, asset.Issuer)
+		names = append(names, asset.Name)
+	}
+	return issuers, names
+}
+
+func (f TransferFilter) cursorArgs() (sql.NullInt64, sql.NullInt64) {
+	return cursorArgs(f.After)
+}
+
+func (f TransferFilter) limitArg() sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(f.Limit), Valid: f.Limit > 0} // null means no limit
+}
+
+func (f EntityFilter) cursorArgs() (sql.NullInt64, sql.NullInt64) {
+	return cursorArgs(f.After)
+}
+
+func cursorArgs(after *Cursor) (sql.NullInt64, sql.NullInt64) {
+	if after == nil {
+		return sql.NullInt64{}, sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: int64(after.Tick), Valid: true}, sql.NullInt64{Int64: int64(after.EventId), Valid: true}
+}
+
+func (f EntityFilter) tickArgs() (sql.NullInt64, sql.NullInt64) {
+	return sql.NullInt64{Int64: int64(f.FromTick), Valid: f.FromTick > 0}, sql.NullInt64{Int64: int64(f.ToTick), Valid: f.ToTick > 0}
+}
+
+func (f EntityFilter) limitArg() sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(f.Limit), Valid: f.Limit > 0} // null means no limit
+}
+
 // qu transfer events
 
 func (r *PgRepository) GetQuTransferEventsForTick(ctx context.Context, tickNumber int) ([]*proto.QuTransferEvent, error) {
@@ -16,7 +90,9 @@ func (r *PgRepository) GetQuTransferEventsForTick(ctx context.Context, tickNumbe
        		ev.amount,
        		tx.hash transactionHash,
        		ti.tick_number tick,
-       		e.event_type eventType
+       		e.event_type eventType,
+       		coalesce((extract(epoch from ti.timestamp) * 1000)::bigint, 0) as timestamp,
+       		e.event_id eventId
 		from qu_transfer_events ev
 		join events e on ev.event_id = e.id
 		join transactions tx on e.transaction_id = tx.id
@@ -33,13 +109,17 @@ func (r *PgRepository) GetQuTransferEventsForTick(ctx context.Context, tickNumbe
 	return events, nil
 }
 
-func (r *PgRepository) GetQuTransferEventsForEntity(ctx context.Context, identity string) ([]*proto.QuTransferEvent, error) {
-	selectSql := `select src.identity sourceId, 
+// GetQuTransferEventsForEntity returns the qu transfers of the entity, latest first.
+func (r *PgRepository) GetQuTransferEventsForEntity(ctx context.Context, identity string, filter EntityFilter) ([]*proto.QuTransferEvent, error) {
+	selectSql := `with entity as (select id from entities where identity = $1)
+		select src.identity sourceId, 
        		dst.identity destinationId,
        		ev.amount,
        		tx.hash transactionHash,
        		ti.tick_number tick,
-       		e.event_type eventType
+       		e.event_type eventType,
+       		coalesce((extract(epoch from ti.timestamp) * 1000)::bigint, 0) as timestamp,
+       		e.event_id eventId
 		from qu_transfer_events ev
 		join events e on ev.event_id = e.id
 		join transactions tx on e.transaction_id = tx.id
@@ -47,17 +127,106 @@ func (r *PgRepository) GetQuTransferEventsForEntity(ctx context.Context, identit
 		join entities src on ev.source_entity_id = src.id
 		join entities dst on ev.destination_entity_id = dst.id
 		where e.event_type = 0
-		and (src.identity = $1 or dst.identity = $1)
-		order by tick_number desc
-		limit 100;`
+		and ((ev.destination_entity_id = (select id from entity) and $6 in (0, 1)) -- incoming
+			or (ev.source_entity_id = (select id from entity) and $6 in (0, 2))) -- outgoing
+		and ($2::timestamptz is null or ti.timestamp >= $2)
+		and ($3::timestamptz is null or ti.timestamp < $3)
+		and ($4::bigint is null or ti.tick_number >= $4)
+		and ($5::bigint is null or ti.tick_number <= $5)
+		and ($7::bigint is null or (ti.tick_number, e.event_id) < ($7, $8::bigint))
+		order by ti.tick_number desc, e.event_id desc
+		limit $9;`
+	fromTick, toTick := filter.tickArgs()
+	afterTick, afterEventId := filter.cursorArgs()
 	var events []*proto.QuTransferEvent
-	err := r.db.SelectContext(ctx, &events, selectSql, identity)
+	err := r.db.SelectContext(ctx, &events, selectSql, identity, nullTime(filter.FromTime), nullTime(filter.ToTime),
+		fromTick, toTick, filter.Direction, afterTick, afterEventId, filter.limitArg())
 	if err != nil {
 		return nil, errors.Wrap(err, "getting asset change events")
 	}
 	return events, nil
 }
 
+// CountQuTransferEventsForEntity counts the qu transfers of the entity. Cursor and limit of the filter are ignored.
+func (r *PgRepository) CountQuTransferEventsForEntity(ctx context.Context, identity string, filter EntityFilter) (int, error) {
+	countSql := `with entity as (select id from entities where identity = $1)
+		select count(*)
+		from qu_transfer_events ev
+		join events e on ev.event_id = e.id
+		join transactions tx on e.transaction_id = tx.id
+		join ticks ti on tx.tick_id = ti.id
+		where e.event_type = 0
+		and ((ev.destination_entity_id = (select id from entity) and $6 in (0, 1)) -- incoming
+			or (ev.source_entity_id = (select id from entity) and $6 in (0, 2))) -- outgoing
+		and ($2::timestamptz is null or ti.timestamp >= $2)
+		and ($3::timestamptz is null or ti.timestamp < $3)
+		and ($4::bigint is null or ti.tick_number >= $4)
+		and ($5::bigint is null or ti.tick_number <= $5);`
+	fromTick, toTick := filter.tickArgs()
+	var count int
+	err := r.db.GetContext(ctx, &count, countSql, identity, nullTime(filter.FromTime), nullTime(filter.ToTime),
+		fromTick, toTick, filter.Direction)
+	return count, errors.Wrap(err, "counting qu transfer events")
+}
+
+func (r *PgRepository) GetQuTransferEventsForTransaction(ctx context.Context, hash string) ([]*proto.QuTransferEvent, error) {
+	selectSql := `select src.identity sourceId, 
+       		dst.identity destinationId,
+       		ev.amount,
+       		tx.hash transactionHash,
+       		ti.tick_number tick,
+       		e.event_type eventType,
+       		coalesce((extract(epoch from ti.timestamp) * 1000)::bigint, 0) as timestamp,
+       		e.event_id eventId
+		from qu_transfer_events ev
+		join events e on ev.event_id = e.id
+		join transactions tx on e.transaction_id = tx.id
+		join ticks ti on tx.tick_id = ti.id
+		join entities src on ev.source_entity_id = src.id
+		join entities dst on ev.destination_entity_id = dst.id
+		where tx.hash = $1 and e.event_type = 0
+		order by e.event_id;`
+	var events []*proto.QuTransferEvent
+	err := r.db.SelectContext(ctx, &events, selectSql, hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting qu transfer events")
+	}
+	return events, nil
+}
+
+// GetQuTransferEventsForTickRange returns the matching qu transfers of the ticks (inclusive) in event order.
+func (r *PgRepository) GetQuTransferEventsForTickRange(ctx context.Context, fromTick, toTick uint32, filter TransferFilter) ([]*proto.QuTransferEvent, error) {
+	selectSql := `select src.identity sourceId, 
+       		dst.identity destinationId,
+       		ev.amount,
+       		tx.hash transactionHash,
+       		ti.tick_number tick,
+       		e.event_type eventType,
+       		coalesce((extract(epoch from ti.timestamp) * 1000)::bigint, 0) as timestamp,
+       		e.event_id eventId
+		from qu_transfer_events ev
+		join events e on ev.event_id = e.id
+		join transactions tx on e.transaction_id = tx.id
+		join ticks ti on tx.tick_id = ti.id
+		join entities src on ev.source_entity_id = src.id
+		join entities dst on ev.destination_entity_id = dst.id
+		where ti.tick_number between $1 and $2 
+		and e.event_type = 0
+		and ((cardinality($3::text[]) = 0 and $4::int = 0) -- no filter
+			or src.identity = any($3) or dst.identity = any($3))
+		and ($5::bigint is null or (ti.tick_number, e.event_id) > ($5, $6::bigint))
+		order by ti.tick_number, e.event_id
+		limit $7;`
+	afterTick, afterEventId := filter.cursorArgs()
+	var events []*proto.QuTransferEvent
+	err := r.db.SelectContext(ctx, &events, selectSql, fromTick, toTick, filter.identitiesArg(), len(filter.Assets),
+		afterTick, afterEventId, filter.limitArg())
+	if err != nil {
+		return nil, errors.Wrap(err, "getting qu transfer events")
+	}
+	return events, nil
+}
+
 // asset change events
 
 func (r *PgRepository) GetAssetChangeEventsForTick(ctx context.Context, tickNumber int) ([]*proto.AssetChangeEvent, error) {
@@ -68,7 +237,10 @@ func (r *PgRepository) GetAssetChangeEventsForTick(ctx context.Context, tickNumb
        		ev.number_of_shares numberOfShares,
        		tx.hash transactionHash,
        		ti.tick_number tick,
-       		e.event_type eventType
+       		e.event_type eventType,
+       		coalesce((extract(epoch from ti.timestamp) * 1000)::bigint, 0) as timestamp,
+       		e.event_id eventId,
+       		coalesce(a.number_of_decimals, 0) numberOfDecimals
 		from asset_change_events ev
 		join events e on ev.event_id = e.id
 		join transactions tx on e.transaction_id = tx.id
@@ -85,18 +257,24 @@ func (r *PgRepository) GetAssetChangeEventsForTick(ctx context.Context, tickNumb
 	if err != nil {
 		return nil, errors.Wrap(err, "getting asset change events")
 	}
+	formatAssetChangeEvents(events)
 	return events, nil
 }
 
-func (r *PgRepository) GetAssetChangeEventsForEntity(ctx context.Context, identity string) ([]*proto.AssetChangeEvent, error) {
-	selectSql := `select src.identity sourceId, 
+// GetAssetChangeEventsForEntity returns the asset changes of the entity, latest first.
+func (r *PgRepository) GetAssetChangeEventsForEntity(ctx context.Context, identity string, filter EntityFilter) ([]*proto.AssetChangeEvent, error) {
+	selectSql := `with entity as (select id from entities where identity = $1)
+		select src.identity sourceId, 
        		dst.identity destinationId, 
        		issuer.identity issuerId,
        		a.name, 
        		ev.number_of_shares numberOfShares,
        		tx.hash transactionHash,
        		ti.tick_number tick,
-       		e.event_type eventType
+       		e.event_type eventType,
+       		coalesce((extract(epoch from ti.timestamp) * 1000)::bigint, 0) as timestamp,
+       		e.event_id eventId,
+       		coalesce(a.number_of_decimals, 0) numberOfDecimals
 		from asset_change_events ev
 		join events e on ev.event_id = e.id
 		join transactions tx on e.transaction_id = tx.id
@@ -106,12 +284,352 @@ func (r *PgRepository) GetAssetChangeEventsForEntity(ctx context.Context, identi
 		join entities src on ev.source_entity_id = src.id
 		join entities dst on ev.destination_entity_id = dst.id
 		where e.event_type in (2, 3)
-		and (src.identity = $1 or dst.identity = $1)
-		order by tick_number desc;`
+		and ((ev.destination_entity_id = (select id from entity) and $6 in (0, 1)) -- incoming
+			or (ev.source_entity_id = (select id from entity) and $6 in (0, 2))) -- outgoing
+		and ($2::timestamptz is null or ti.timestamp >= $2)
+		and ($3::timestamptz is null or ti.timestamp < $3)
+		and ($4::bigint is null or ti.tick_number >= $4)
+		and ($5::bigint is null or ti.tick_number <= $5)
+		and ($7::bigint is null or (ti.tick_number, e.event_id) < ($7, $8::bigint))
+		order by ti.tick_number desc, e.event_id desc
+		limit $9;`
+	fromTick, toTick := filter.tickArgs()
+	afterTick, afterEventId := filter.cursorArgs()
 	var events []*proto.AssetChangeEvent
-	err := r.db.SelectContext(ctx, &events, selectSql, identity)
+	err := r.db.SelectContext(ctx, &events, selectSql, identity, nullTime(filter.FromTime), nullTime(filter.ToTime),
+		fromTick, toTick, filter.Direction, afterTick, afterEventId, filter.limitArg())
 	if err != nil {
 		return nil, errors.Wrap(err, "getting asset change events")
 	}
+	formatAssetChangeEvents(events)
 	return events, nil
 }
+
+// CountAssetChangeEventsForEntity counts the asset changes of the entity. Cursor and limit of the filter are ignored.
+func (r *PgRepository) CountAssetChangeEventsForEntity(ctx context.Context, identity string, filter EntityFilter) (int, error) {
+	countSql := `with entity as (select id from entities where identity = $1)
+		select count(*)
+		from asset_change_events ev
+		join events e on ev.event_id = e.id
+		join transactions tx on e.transaction_id = tx.id
+		join ticks ti on tx.tick_id = ti.id
+		where e.event_type in (2, 3)
+		and ((ev.destination_entity_id = (select id from entity) and $6 in (0, 1)) -- incoming
+			or (ev.source_entity_id = (select id from entity) and $6 in (0, 2))) -- outgoing
+		and ($2::timestamptz is null or ti.timestamp >= $2)
+		and ($3::timestamptz is null or ti.timestamp < $3)
+		and ($4::bigint is null or ti.tick_number >= $4)
+		and ($5::bigint is null or ti.tick_number <= $5);`
+	fromTick, toTick := filter.tickArgs()
+	var count int
+	err := r.db.GetContext(ctx, &count, countSql, identity, nullTime(filter.FromTime), nullTime(filter.ToTime),
+		fromTick, toTick, filter.Direction)
+	return count, errors.Wrap(err, "counting asset change events")
+}
+
+func (r *PgRepository) GetAssetChangeEventsForTransaction(ctx context.Context, hash string) ([]*proto.AssetChangeEvent, error) {
+	selectSql := `select src.identity sourceId, 
+       		dst.identity destinationId, 
+       		issuer.identity issuerId,
+       		a.name, 
+       		ev.number_of_shares numberOfShares,
+       		tx.hash transactionHash,
+       		ti.tick_number tick,
+       		e.event_type eventType,
+       		coalesce((extract(epoch from ti.timestamp) * 1000)::bigint, 0) as timestamp,
+       		e.event_id eventId,
+       		coalesce(a.number_of_decimals, 0) numberOfDecimals
+		from asset_change_events ev
+		join events e on ev.event_id = e.id
+		join transactions tx on e.transaction_id = tx.id
+		join ticks ti on tx.tick_id = ti.id
+		join assets a on ev.asset_id = a.id
+		join entities issuer on a.issuer_id = issuer.id
+		join entities src on ev.source_entity_id = src.id
+		join entities dst on ev.destination_entity_id = dst.id
+		where tx.hash = $1
+		and e.event_type in (2, 3)
+		order by e.event_id;`
+	var events []*proto.AssetChangeEvent
+	err := r.db.SelectContext(ctx, &events, selectSql, hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting asset change events")
+	}
+	formatAssetChangeEvents(events)
+	return events, nil
+}
+
+// GetAssetChangeEventsForAsset returns the changes of the asset, latest first. The direction of the filter is ignored.
+func (r *PgRepository) GetAssetChangeEventsForAsset(ctx context.Context, issuer, name string, filter EntityFilter) ([]*proto.AssetChangeEvent, error) {
+	selectSql := `with asset as (select a.id, a.number_of_decimals
+       			from assets a
+       			join entities issuer on a.issuer_id = issuer.id
+       			where issuer.identity = $1 and a.name = $2)
+		select src.identity sourceId, 
+       		dst.identity destinationId, 
+       		$1 issuerId,
+       		$2 as name, 
+       		ev.number_of_shares numberOfShares,
+       		tx.hash transactionHash,
+       		ti.tick_number tick,
+       		e.event_type eventType,
+       		coalesce((extract(epoch from ti.timestamp) * 1000)::bigint, 0) as timestamp,
+       		e.event_id eventId,
+       		coalesce((select number_of_decimals from asset), 0) numberOfDecimals
+		from asset_change_events ev
+		join events e on ev.event_id = e.id
+		join transactions tx on e.transaction_id = tx.id
+		join ticks ti on tx.tick_id = ti.id
+		join entities src on ev.source_entity_id = src.id
+		join entities dst on ev.destination_entity_id = dst.id
+		where ev.asset_id = (select id from asset)
+		and e.event_type in (2, 3)
+		and ($3::timestamptz is null or ti.timestamp >= $3)
+		and ($4::timestamptz is null or ti.timestamp < $4)
+		and ($5::bigint is null or ti.tick_number >= $5)
+		and ($6::bigint is null or ti.tick_number <= $6)
+		and ($7::bigint is null or (ti.tick_number, e.event_id) < ($7, $8::bigint))
+		order by ti.tick_number desc, e.event_id desc
+		limit $9;`
+	fromTick, toTick := filter.tickArgs()
+	afterTick, afterEventId := filter.cursorArgs()
+	var events []*proto.AssetChangeEvent
+	err := r.db.SelectContext(ctx, &events, selectSql, issuer, name, nullTime(filter.FromTime), nullTime(filter.ToTime),
+		fromTick, toTick, afterTick, afterEventId, filter.limitArg())
+	if err != nil {
+		return nil, errors.Wrap(err, "getting asset change events")
+	}
+	formatAssetChangeEvents(events)
+	return events, nil
+}
+
+// CountAssetChangeEventsForAsset counts the changes of the asset. Cursor and limit of the filter are ignored.
+func (r *PgRepository) CountAssetChangeEventsForAsset(ctx context.Context, issuer, name string, filter EntityFilter) (int, error) {
+	countSql := `with asset as (select a.id
+       			from assets a
+       			join entities issuer on a.issuer_id = issuer.id
+       			where issuer.identity = $1 and a.name = $2)
+		select count(*)
+		from asset_change_events ev
+		join events e on ev.event_id = e.id
+		join transactions tx on e.transaction_id = tx.id
+		join ticks ti on tx.tick_id = ti.id
+		where ev.asset_id = (select id from asset)
+		and e.event_type in (2, 3)
+		and ($3::timestamptz is null or ti.timestamp >= $3)
+		and ($4::timestamptz is null or ti.timestamp < $4)
+		and ($5::bigint is null or ti.tick_number >= $5)
+		and ($6::bigint is null or ti.tick_number <= $6);`
+	fromTick, toTick := filter.tickArgs()
+	var count int
+	err := r.db.GetContext(ctx, &count, countSql, issuer, name, nullTime(filter.FromTime), nullTime(filter.ToTime),
+		fromTick, toTick)
+	return count, errors.Wrap(err, "counting asset change events")
+}
+
+// GetAssetChangeEventsForTickRange returns the matching asset changes of the ticks (inclusive) in event order.
+func (r *PgRepository) GetAssetChangeEventsForTickRange(ctx context.Context, fromTick, toTick uint32, filter TransferFilter) ([]*proto.AssetChangeEvent, error) {
+	selectSql := `select src.identity sourceId, 
+       		dst.identity destinationId, 
+       		issuer.identity issuerId,
+       		a.name, 
+       		ev.number_of_shares numberOfShares,
+       		tx.hash transactionHash,
+       		ti.tick_number tick,
+       		e.event_type eventType,
+       		coalesce((extract(epoch from ti.timestamp) * 1000)::bigint, 0) as timestamp,
+       		e.event_id eventId,
+       		coalesce(a.number_of_decimals, 0) numberOfDecimals
+		from asset_change_events ev
+		join events e on ev.event_id = e.id
+		join transactions tx on e.transaction_id = tx.id
+		join ticks ti on tx.tick_id = ti.id
+		join assets a on ev.asset_id = a.id
+		join entities issuer on a.issuer_id = issuer.id
+		join entities src on ev.source_entity_id = src.id
+		join entities dst on ev.destination_entity_id = dst.id
+		where ti.tick_number between $1 and $2
+		and e.event_type in (2, 3)
+		and ((cardinality($3::text[]) = 0 and cardinality($4::text[]) = 0) -- no filter
+			or src.identity = any($3) or dst.identity = any($3)
+			or (issuer.identity, a.name) in (select * from unnest($4::text[], $5::text[])))
+		and ($6::bigint is null or (ti.tick_number, e.event_id) > ($6, $7::bigint))
+		order by ti.tick_number, e.event_id
+		limit $8;`
+	issuers, names := filter.assetArgs()
+	afterTick, afterEventId := filter.cursorArgs()
+	var events []*proto.AssetChangeEvent
+	err := r.db.SelectContext(ctx, &events, selectSql, fromTick, toTick, filter.identitiesArg(), issuers, names,
+		afterTick, afterEventId, filter.limitArg())
+	if err != nil {
+		return nil, errors.Wrap(err, "getting asset change events")
+	}
+	formatAssetChangeEvents(events)
+	return events, nil
+}
+
+// EntityQuTransferEvent is a qu transfer with the requested entity, that is source or destination.
+type EntityQuTransferEvent struct {
+	Identity string `db:"identity"`
+	*proto.QuTransferEvent
+}
+
+// EntityAssetChangeEvent is an asset change with the requested entity, that is source or destination.
+type EntityAssetChangeEvent struct {
+	Identity string `db:"identity"`
+	*proto.AssetChangeEvent
+}
+
+// GetQuTransferEventsForEntities returns the qu transfers of all entities within the tick range (inclusive) ordered by
+// entity, latest first. Transfers between two of the entities are returned for both of them.
+func (r *PgRepository) GetQuTransferEventsForEntities(ctx context.Context, identities []string, fromTick, toTick uint32) ([]*EntityQuTransferEvent, error) {
+	selectSql := `with entity as (select id, identity from entities where identity = any($1))
+		select en.identity,
+       		src.identity sourceId, 
+       		dst.identity destinationId,
+       		ev.amount,
+       		tx.hash transactionHash,
+       		ti.tick_number tick,
+       		e.event_type eventType,
+       		coalesce((extract(epoch from ti.timestamp) * 1000)::bigint, 0) as timestamp,
+       		e.event_id eventId
+		from entity en
+		join qu_transfer_events ev on ev.source_entity_id = en.id or ev.destination_entity_id = en.id
+		join events e on ev.event_id = e.id
+		join transactions tx on e.transaction_id = tx.id
+		join ticks ti on tx.tick_id = ti.id
+		join entities src on ev.source_entity_id = src.id
+		join entities dst on ev.destination_entity_id = dst.id
+		where e.event_type = 0
+		and ti.tick_number between $2 and $3
+		order by en.identity, ti.tick_number desc, e.event_id desc;`
+	var events []*EntityQuTransferEvent
+	err := r.db.SelectContext(ctx, &events, selectSql, TransferFilter{Identities: identities}.identitiesArg(), fromTick, toTick)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting qu transfer events for entities")
+	}
+	return events, nil
+}
+
+// GetAssetChangeEventsForEntities returns the asset changes of all entities within the tick range (inclusive) ordered
+// by entity, latest first. Changes between two of the entities are returned for both of them.
+func (r *PgRepository) GetAssetChangeEventsForEntities(ctx context.Context, identities []string, fromTick, toTick uint32) ([]*EntityAssetChangeEvent, error) {
+	selectSql := `with entity as (select id, identity from entities where identity = any($1))
+		select en.identity,
+       		src.identity sourceId, 
+       		dst.identity destinationId, 
+       		issuer.identity issuerId,
+       		a.name, 
+       		ev.number_of_shares numberOfShares,
+       		coalesce(a.number_of_decimals, 0) numberOfDecimals,
+       		tx.hash transactionHash,
+       		ti.tick_number tick,
+       		e.event_type eventType,
+       		coalesce((extract(epoch from ti.timestamp) * 1000)::bigint, 0) as timestamp,
+       		e.event_id eventId
+		from entity en
+		join asset_change_events ev on ev.source_entity_id = en.id or ev.destination_entity_id = en.id
+		join events e on ev.event_id = e.id
+		join transactions tx on e.transaction_id = tx.id
+		join ticks ti on tx.tick_id = ti.id
+		join assets a on ev.asset_id = a.id
+		join entities issuer on a.issuer_id = issuer.id
+		join entities src on ev.source_entity_id = src.id
+		join entities dst on ev.destination_entity_id = dst.id
+		where e.event_type in (2, 3)
+		and ti.tick_number between $2 and $3
+		order by en.identity, ti.tick_number desc, e.event_id desc;`
+	var events []*EntityAssetChangeEvent
+	err := r.db.SelectContext(ctx, &events, selectSql, TransferFilter{Identities: identities}.identitiesArg(), fromTick, toTick)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting asset change events for entities")
+	}
+	for _, event := range events {
+		formatAssetChangeEvent(event.AssetChangeEvent)
+	}
+	return events, nil
+}
+
+// ExportRow is a qu transfer or asset change from the perspective of the exported entity.
+type ExportRow struct {
+	Tick            uint32       `db:"tick"`
+	Timestamp       sql.NullTime `db:"timestamp"`
+	TransactionHash string       `db:"transaction_hash"`
+	EventId         uint64       `db:"event_id"`
+	EventType       uint32       `db:"event_type"`
+	Direction       string       `db:"direction"` // incoming, outgoing or self
+	Counterparty    string       `db:"counterparty"`
+	Amount          uint64       `db:"amount"` // qu amount or number of shares
+	Asset           string       `db:"asset"`  // ISSUER/NAME. empty for qu transfers.
+}
+
+// ExportEventsForEntity passes all qu transfers and asset changes of the entity within the tick range (inclusive, 0
+// means unbounded) in event order to the handler. Rows are read one by one and not held in memory.
+func (r *PgRepository) ExportEventsForEntity(ctx context.Context, identity string, fromTick, toTick uint32, handle func(row *ExportRow) error) error {
+	selectSql := `with entity as (select id from entities where identity = $1)
+		select ti.tick_number tick,
+       		ti.timestamp,
+       		tx.hash transaction_hash,
+       		e.event_id,
+       		e.event_type,
+       		case when ev.source_entity_id = ev.destination_entity_id then 'self'
+       			when ev.source_entity_id = (select id from entity) then 'outgoing'
+       			else 'incoming' end direction,
+       		case when ev.source_entity_id = (select id from entity) then dst.identity else src.identity end counterparty,
+       		ev.amount,
+       		'' asset
+		from qu_transfer_events ev
+		join events e on ev.event_id = e.id
+		join transactions tx on e.transaction_id = tx.id
+		join ticks ti on tx.tick_id = ti.id
+		join entities src on ev.source_entity_id = src.id
+		join entities dst on ev.destination_entity_id = dst.id
+		where e.event_type = 0
+		and (ev.source_entity_id = (select id from entity) or ev.destination_entity_id = (select id from entity))
+		and ($2::bigint is null or ti.tick_number >= $2)
+		and ($3::bigint is null or ti.tick_number <= $3)
+		union all
+		select ti.tick_number,
+       		ti.timestamp,
+       		tx.hash,
+       		e.event_id,
+       		e.event_type,
+       		case when ev.source_entity_id = ev.destination_entity_id then 'self'
+       			when ev.source_entity_id = (select id from entity) then 'outgoing'
+       			else 'incoming' end,
+       		case when ev.source_entity_id = (select id from entity) then dst.identity else src.identity end,
+       		ev.number_of_shares,
+       		issuer.identity || '/' || a.name
+		from asset_change_events ev
+		join events e on ev.event_id = e.id
+		join transactions tx on e.transaction_id = tx.id
+		join ticks ti on tx.tick_id = ti.id
+		join assets a on ev.asset_id = a.id
+		join entities issuer on a.issuer_id = issuer.id
+		join entities src on ev.source_entity_id = src.id
+		join entities dst on ev.destination_entity_id = dst.id
+		where e.event_type in (2, 3)
+		and (ev.source_entity_id = (select id from entity) or ev.destination_entity_id = (select id from entity))
+		and ($2::bigint is null or ti.tick_number >= $2)
+		and ($3::bigint is null or ti.tick_number <= $3)
+		order by tick, event_id;`
+	fromTickArg, toTickArg := EntityFilter{FromTick: fromTick, ToTick: toTick}.tickArgs()
+	rows, err := r.db.QueryxContext(ctx, selectSql, identity, fromTickArg, toTickArg)
+	if err != nil {
+		return errors.Wrap(err, "querying events for export")
+	}
+	defer rows.Close()
+
+	var row ExportRow
+	for rows.Next() {
+		err = rows.StructScan(&row)
+		if err != nil {
+			return errors.Wrap(err, "scanning export row")
+		}
+		err = handle(&row)
+		if err != nil {
+			return errors.Wrap(err, "handling export row")
+		}
+	}
+	return errors.Wrap(rows.Err(), "reading export rows")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"go-transfers/proto"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -20,14 +21,17 @@ func TestPgRepository_GetAssetChangeEventsForTick(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(events))
 	assert.Equal(t, &proto.AssetChangeEvent{
-		SourceId:        testSourceIdentity,
-		DestinationId:   testDestinationEntity,
-		IssuerId:        AAA,
-		Name:            "QX",
-		NumberOfShares:  123456789,
-		TransactionHash: testTransactionHash,
-		Tick:            testTickNumber,
-		EventType:       2,
+		SourceId:                testSourceIdentity,
+		DestinationId:           testDestinationEntity,
+		IssuerId:                AAA,
+		Name:                    "QX",
+		NumberOfShares:          123456789,
+		FormattedNumberOfShares: "123456789",
+		TransactionHash:         testTransactionHash,
+		Tick:                    testTickNumber,
+		EventType:               2,
+		Timestamp:               uint64(testTickTime.UnixMilli()),
+		EventId:                 1,
 	}, events[0])
 
 	deleteAssetChangeEvent(assetEventId, t)
@@ -53,6 +57,8 @@ func TestPgRepository_GetQuTransferEventsForTick(t *testing.T) {
 		TransactionHash: testTransactionHash,
 		Tick:            testTickNumber,
 		EventType:       0,
+		Timestamp:       uint64(testTickTime.UnixMilli()),
+		EventId:         1,
 	}, events[0])
 
 	// clean up
@@ -69,7 +75,7 @@ func TestPgRepository_GetQuTransferEventsForEntity(t *testing.T) {
 	transferId, err := repository.GetOrCreateQuTransferEvent(context.Background(), eventId, sourceEntityId, destinationEntityId, 123_456_789_012_345)
 	assert.Nil(t, err)
 
-	events, err := repository.GetQuTransferEventsForEntity(context.Background(), testSourceIdentity)
+	events, err := repository.GetQuTransferEventsForEntity(context.Background(), testSourceIdentity, EntityFilter{})
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(events))
 	assert.Equal(t, &proto.QuTransferEvent{
@@ -79,9 +85,11 @@ func TestPgRepository_GetQuTransferEventsForEntity(t *testing.T) {
 		TransactionHash: testTransactionHash,
 		Tick:            testTickNumber,
 		EventType:       0,
+		Timestamp:       uint64(testTickTime.UnixMilli()),
+		EventId:         1,
 	}, events[0])
 
-	events, err = repository.GetQuTransferEventsForEntity(context.Background(), testDestinationEntity)
+	events, err = repository.GetQuTransferEventsForEntity(context.Background(), testDestinationEntity, EntityFilter{})
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(events))
 	assert.Equal(t, &proto.QuTransferEvent{
@@ -91,6 +99,8 @@ func TestPgRepository_GetQuTransferEventsForEntity(t *testing.T) {
 		TransactionHash: testTransactionHash,
 		Tick:            testTickNumber,
 		EventType:       0,
+		Timestamp:       uint64(testTickTime.UnixMilli()),
+		EventId:         1,
 	}, events[0])
 
 	// clean up
@@ -108,32 +118,38 @@ func TestPgRepository_GetAssetChangeEventsForEntity(t *testing.T) {
 	assetEventId, err := repository.insertAssetChangeEvent(context.Background(), eventId, assetId, sourceEntityId, destinationEntityId, 123456789)
 	assert.Nil(t, err)
 
-	events, err := repository.GetAssetChangeEventsForEntity(context.Background(), testSourceIdentity)
+	events, err := repository.GetAssetChangeEventsForEntity(context.Background(), testSourceIdentity, EntityFilter{})
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(events))
 	assert.Equal(t, &proto.AssetChangeEvent{
-		SourceId:        testSourceIdentity,
-		DestinationId:   testDestinationEntity,
-		IssuerId:        AAA,
-		Name:            "QX",
-		NumberOfShares:  123456789,
-		TransactionHash: testTransactionHash,
-		Tick:            testTickNumber,
-		EventType:       2,
+		SourceId:                testSourceIdentity,
+		DestinationId:           testDestinationEntity,
+		IssuerId:                AAA,
+		Name:                    "QX",
+		NumberOfShares:          123456789,
+		FormattedNumberOfShares: "123456789",
+		TransactionHash:         testTransactionHash,
+		Tick:                    testTickNumber,
+		EventType:               2,
+		Timestamp:               uint64(testTickTime.UnixMilli()),
+		EventId:                 1,
 	}, events[0])
 
-	events, err = repository.GetAssetChangeEventsForEntity(context.Background(), testDestinationEntity)
+	events, err = repository.GetAssetChangeEventsForEntity(context.Background(), testDestinationEntity, EntityFilter{})
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(events))
 	assert.Equal(t, &proto.AssetChangeEvent{
-		SourceId:        testSourceIdentity,
-		DestinationId:   testDestinationEntity,
-		IssuerId:        AAA,
-		Name:            "QX",
-		NumberOfShares:  123456789,
-		TransactionHash: testTransactionHash,
-		Tick:            testTickNumber,
-		EventType:       2,
+		SourceId:                testSourceIdentity,
+		DestinationId:           testDestinationEntity,
+		IssuerId:                AAA,
+		Name:                    "QX",
+		NumberOfShares:          123456789,
+		FormattedNumberOfShares: "123456789",
+		TransactionHash:         testTransactionHash,
+		Tick:                    testTickNumber,
+		EventType:               2,
+		Timestamp:               uint64(testTickTime.UnixMilli()),
+		EventId:                 1,
 	}, events[0])
 
 	deleteAssetChangeEvent(assetEventId, t)
@@ -141,3 +157,329 @@ func TestPgRepository_GetAssetChangeEventsForEntity(t *testing.T) {
 	deleteEntity(sourceEntityId, t)
 	deleteEntity(destinationEntityId, t)
 }
+
+func TestPgRepository_GetQuTransferEventsForEntity_GivenTimeRange_ThenFilter(t *testing.T) {
+	tickId, transactionId, eventId := setupEventTestData(t, 0)
+	sourceEntityId, destinationEntityId := setupSourceAndDestinationEntity(t)
+
+	transferId, err := repository.GetOrCreateQuTransferEvent(context.Background(), eventId, sourceEntityId, destinationEntityId, 123_456_789_012_345)
+	assert.Nil(t, err)
+
+	events, err := repository.GetQuTransferEventsForEntity(context.Background(), testSourceIdentity, EntityFilter{
+		FromTime: testTickTime,
+		ToTime:   testTickTime.Add(time.Second),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(events))
+
+	events, err = repository.GetQuTransferEventsForEntity(context.Background(), testSourceIdentity, EntityFilter{
+		FromTime: testTickTime.Add(time.Second),
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, events)
+
+	events, err = repository.GetQuTransferEventsForEntity(context.Background(), testSourceIdentity, EntityFilter{
+		ToTime: testTickTime,
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, events)
+
+	// clean up
+	deleteTransferQuEvent(transferId, t)
+	cleanupEventTestData(t, transactionId, tickId, eventId)
+	deleteEntity(sourceEntityId, t)
+	deleteEntity(destinationEntityId, t)
+}
+
+func TestPgRepository_GetQuTransferEventsForEntity_GivenCursor_ThenPage(t *testing.T) {
+	tickId, transactionId, eventId := setupEventTestData(t, 0)
+	otherEventId, err := repository.GetOrCreateEvent(context.Background(), transactionId, 2, 0, "bar")
+	assert.Nil(t, err)
+	sourceEntityId, destinationEntityId := setupSourceAndDestinationEntity(t)
+
+	transferId, err := repository.GetOrCreateQuTransferEvent(context.Background(), eventId, sourceEntityId, destinationEntityId, 1)
+	assert.Nil(t, err)
+	otherTransferId, err := repository.GetOrCreateQuTransferEvent(context.Background(), otherEventId, sourceEntityId, destinationEntityId, 2)
+	assert.Nil(t, err)
+
+	events, err := repository.GetQuTransferEventsForEntity(context.Background(), testSourceIdentity, EntityFilter{Limit: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, uint64(2), events[0].EventId) // latest first
+
+	events, err = repository.GetQuTransferEventsForEntity(context.Background(), testSourceIdentity, EntityFilter{
+		After: &Cursor{Tick: testTickNumber, EventId: 2},
+		Limit: 1,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, uint64(1), events[0].EventId)
+
+	count, err := repository.CountQuTransferEventsForEntity(context.Background(), testSourceIdentity, EntityFilter{Limit: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, count)
+
+	// clean up
+	deleteTransferQuEvent(otherTransferId, t)
+	deleteTransferQuEvent(transferId, t)
+	deleteEvent(otherEventId, t)
+	cleanupEventTestData(t, transactionId, tickId, eventId)
+	deleteEntity(sourceEntityId, t)
+	deleteEntity(destinationEntityId, t)
+}
+
+func TestPgRepository_GetQuTransferEventsForEntity_GivenDirectionAndTicks_ThenFilter(t *testing.T) {
+	tickId, transactionId, eventId := setupEventTestData(t, 0)
+	sourceEntityId, destinationEntityId := setupSourceAndDestinationEntity(t)
+
+	transferId, err := repository.GetOrCreateQuTransferEvent(context.Background(), eventId, sourceEntityId, destinationEntityId, 42)
+	assert.Nil(t, err)
+
+	events, err := repository.GetQuTransferEventsForEntity(context.Background(), testSourceIdentity, EntityFilter{Direction: proto.Direction_OUTGOING})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(events))
+
+	events, err = repository.GetQuTransferEventsForEntity(context.Background(), testSourceIdentity, EntityFilter{Direction: proto.Direction_INCOMING})
+	assert.Nil(t, err)
+	assert.Empty(t, events)
+
+	events, err = repository.GetQuTransferEventsForEntity(context.Background(), testDestinationEntity, EntityFilter{
+		Direction: proto.Direction_INCOMING,
+		FromTick:  testTickNumber,
+		ToTick:    testTickNumber,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(events))
+
+	events, err = repository.GetQuTransferEventsForEntity(context.Background(), testDestinationEntity, EntityFilter{FromTick: testTickNumber + 1})
+	assert.Nil(t, err)
+	assert.Empty(t, events)
+
+	// clean up
+	deleteTransferQuEvent(transferId, t)
+	cleanupEventTestData(t, transactionId, tickId, eventId)
+	deleteEntity(sourceEntityId, t)
+	deleteEntity(destinationEntityId, t)
+}
+
+func TestPgRepository_GetEventsForTransaction(t *testing.T) {
+	tickId, transactionId, eventId := setupEventTestData(t, 0)
+	sourceEntityId, destinationEntityId := setupSourceAndDestinationEntity(t)
+
+	transferId, err := repository.GetOrCreateQuTransferEvent(context.Background(), eventId, sourceEntityId, destinationEntityId, 42)
+	assert.Nil(t, err)
+
+	quTransfers, err := repository.GetQuTransferEventsForTransaction(context.Background(), testTransactionHash)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(quTransfers))
+	assert.Equal(t, uint64(42), quTransfers[0].Amount)
+
+	assetChanges, err := repository.GetAssetChangeEventsForTransaction(context.Background(), testTransactionHash)
+	assert.Nil(t, err)
+	assert.Empty(t, assetChanges)
+
+	// clean up
+	deleteTransferQuEvent(transferId, t)
+	cleanupEventTestData(t, transactionId, tickId, eventId)
+	deleteEntity(sourceEntityId, t)
+	deleteEntity(destinationEntityId, t)
+}
+
+func TestPgRepository_GetAssetChangeEventsForAsset(t *testing.T) {
+	tickId, transactionId, eventId := setupEventTestData(t, 2)
+	sourceEntityId, destinationEntityId := setupSourceAndDestinationEntity(t)
+	assetId, err := repository.getAssetId(context.Background(), AAA, "QX") // don't clean up
+	assert.Nil(t, err)
+	assetEventId, err := repository.insertAssetChangeEvent(context.Background(), eventId, assetId, sourceEntityId, destinationEntityId, 123456789)
+	assert.Nil(t, err)
+
+	events, err := repository.GetAssetChangeEventsForAsset(context.Background(), AAA, "QX", EntityFilter{FromTick: testTickNumber, ToTick: testTickNumber})
+	assert.Nil(t, err)
+	assert.Equal(t, []*proto.AssetChangeEvent{{
+		SourceId:                testSourceIdentity,
+		DestinationId:           testDestinationEntity,
+		IssuerId:                AAA,
+		Name:                    "QX",
+		NumberOfShares:          123456789,
+		FormattedNumberOfShares: "123456789",
+		TransactionHash:         testTransactionHash,
+		Tick:                    testTickNumber,
+		EventType:               2,
+		Timestamp:               uint64(testTickTime.UnixMilli()),
+		EventId:                 1,
+	}}, events)
+
+	count, err := repository.CountAssetChangeEventsForAsset(context.Background(), AAA, "QX", EntityFilter{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+
+	events, err = repository.GetAssetChangeEventsForAsset(context.Background(), AAA, "QX", EntityFilter{FromTick: testTickNumber + 1})
+	assert.Nil(t, err)
+	assert.Empty(t, events)
+
+	events, err = repository.GetAssetChangeEventsForAsset(context.Background(), AAA, "QUTIL", EntityFilter{})
+	assert.Nil(t, err)
+	assert.Empty(t, events)
+
+	deleteAssetChangeEvent(assetEventId, t)
+	cleanupEventTestData(t, transactionId, tickId, eventId)
+	deleteEntity(sourceEntityId, t)
+	deleteEntity(destinationEntityId, t)
+}
+
+func TestPgRepository_GetEventsForTickRange_GivenFilter_ThenMatch(t *testing.T) {
+	tickId, transactionId, eventId := setupEventTestData(t, 0)
+	sourceEntityId, destinationEntityId := setupSourceAndDestinationEntity(t)
+	transferId, err := repository.GetOrCreateQuTransferEvent(context.Background(), eventId, sourceEntityId, destinationEntityId, 42)
+	assert.Nil(t, err)
+
+	events, err := repository.GetQuTransferEventsForTickRange(context.Background(), testTickNumber, testTickNumber, TransferFilter{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(events))
+
+	events, err = repository.GetQuTransferEventsForTickRange(context.Background(), testTickNumber, testTickNumber, TransferFilter{Identities: []string{testDestinationEntity}})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(events))
+
+	events, err = repository.GetQuTransferEventsForTickRange(context.Background(), testTickNumber, testTickNumber, TransferFilter{Identities: []string{AAA}})
+	assert.Nil(t, err)
+	assert.Empty(t, events)
+
+	events, err = repository.GetQuTransferEventsForTickRange(context.Background(), testTickNumber, testTickNumber, TransferFilter{Assets: []AssetKey{{Issuer: AAA, Name: "QX"}}})
+	assert.Nil(t, err)
+	assert.Empty(t, events) // asset filter only
+
+	events, err = repository.GetQuTransferEventsForTickRange(context.Background(), testTickNumber+1, testTickNumber+10, TransferFilter{})
+	assert.Nil(t, err)
+	assert.Empty(t, events)
+
+	events, err = repository.GetQuTransferEventsForTickRange(context.Background(), testTickNumber, testTickNumber, TransferFilter{Limit: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(events))
+
+	after := &Cursor{Tick: testTickNumber, EventId: events[0].EventId}
+	events, err = repository.GetQuTransferEventsForTickRange(context.Background(), testTickNumber, testTickNumber, TransferFilter{After: after})
+	assert.Nil(t, err)
+	assert.Empty(t, events)
+
+	deleteTransferQuEvent(transferId, t)
+	cleanupEventTestData(t, transactionId, tickId, eventId)
+	deleteEntity(sourceEntityId, t)
+	deleteEntity(destinationEntityId, t)
+}
+
+func TestPgRepository_GetAssetChangeEventsForTickRange_GivenFilter_ThenMatch(t *testing.T) {
+	tickId, transactionId, eventId := setupEventTestData(t, 2)
+	sourceEntityId, destinationEntityId := setupSourceAndDestinationEntity(t)
+	assetId, err := repository.getAssetId(context.Background(), AAA, "QX") // don't clean up
+	assert.Nil(t, err)
+	assetEventId, err := repository.insertAssetChangeEvent(context.Background(), eventId, assetId, sourceEntityId, destinationEntityId, 123456789)
+	assert.Nil(t, err)
+
+	events, err := repository.GetAssetChangeEventsForTickRange(context.Background(), testTickNumber, testTickNumber, TransferFilter{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(events))
+
+	events, err = repository.GetAssetChangeEventsForTickRange(context.Background(), testTickNumber, testTickNumber, TransferFilter{Assets: []AssetKey{{Issuer: AAA, Name: "QX"}}})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(events))
+
+	events, err = repository.GetAssetChangeEventsForTickRange(context.Background(), testTickNumber, testTickNumber, TransferFilter{Identities: []string{testSourceIdentity}})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(events))
+
+	events, err = repository.GetAssetChangeEventsForTickRange(context.Background(), testTickNumber, testTickNumber, TransferFilter{Assets: []AssetKey{{Issuer: AAA, Name: "QUTIL"}}})
+	assert.Nil(t, err)
+	assert.Empty(t, events)
+
+	deleteAssetChangeEvent(assetEventId, t)
+	cleanupEventTestData(t, transactionId, tickId, eventId)
+	deleteEntity(sourceEntityId, t)
+	deleteEntity(destinationEntityId, t)
+}
+
+func TestPgRepository_GetQuTransferEventsForEntities(t *testing.T) {
+	tickId, transactionId, eventId := setupEventTestData(t, 0)
+	sourceEntityId, destinationEntityId := setupSourceAndDestinationEntity(t)
+	transferId, err := repository.GetOrCreateQuTransferEvent(context.Background(), eventId, sourceEntityId, destinationEntityId, 42)
+	assert.Nil(t, err)
+
+	events, err := repository.GetQuTransferEventsForEntities(context.Background(), []string{testSourceIdentity, testDestinationEntity, AAA}, testTickNumber, testTickNumber)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(events)) // once for source and once for destination
+	assert.Equal(t, testSourceIdentity, events[0].Identity)
+	assert.Equal(t, testDestinationEntity, events[1].Identity)
+	assert.Equal(t, uint64(42), events[0].Amount)
+	assert.Equal(t, events[0].EventId, events[1].EventId)
+
+	events, err = repository.GetQuTransferEventsForEntities(context.Background(), []string{testSourceIdentity}, testTickNumber+1, testTickNumber+10)
+	assert.Nil(t, err)
+	assert.Empty(t, events)
+
+	deleteTransferQuEvent(transferId, t)
+	cleanupEventTestData(t, transactionId, tickId, eventId)
+	deleteEntity(sourceEntityId, t)
+	deleteEntity(destinationEntityId, t)
+}
+
+func TestPgRepository_GetAssetChangeEventsForEntities(t *testing.T) {
+	tickId, transactionId, eventId := setupEventTestData(t, 2)
+	sourceEntityId, destinationEntityId := setupSourceAndDestinationEntity(t)
+	assetId, err := repository.GetOrCreateAsset(context.Background(), AAA, "TESTAS")
+	assert.Nil(t, err)
+	assert.Nil(t, repository.UpdateAssetDecimals(context.Background(), assetId, 2, ""))
+	assetEventId, err := repository.insertAssetChangeEvent(context.Background(), eventId, assetId, sourceEntityId, destinationEntityId, 123456789)
+	assert.Nil(t, err)
+
+	events, err := repository.GetAssetChangeEventsForEntities(context.Background(), []string{testDestinationEntity}, testTickNumber, testTickNumber)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, testDestinationEntity, events[0].Identity)
+	assert.Equal(t, "TESTAS", events[0].Name)
+	assert.Equal(t, uint64(123456789), events[0].NumberOfShares)
+	assert.Equal(t, uint32(2), events[0].NumberOfDecimals)
+	assert.Equal(t, "1234567.89", events[0].FormattedNumberOfShares)
+
+	deleteAssetChangeEvent(assetEventId, t)
+	deleteAsset(assetId, t)
+	cleanupEventTestData(t, transactionId, tickId, eventId)
+	deleteEntity(sourceEntityId, t)
+	deleteEntity(destinationEntityId, t)
+}
+
+func TestPgRepository_ExportEventsForEntity(t *testing.T) {
+	tickId, transactionId, eventId := setupEventTestData(t, 0)
+	sourceEntityId, destinationEntityId := setupSourceAndDestinationEntity(t)
+	transferId, err := repository.GetOrCreateQuTransferEvent(context.Background(), eventId, sourceEntityId, destinationEntityId, 42)
+	assert.Nil(t, err)
+
+	var rows []ExportRow
+	collect := func(row *ExportRow) error {
+		rows = append(rows, *row)
+		return nil
+	}
+	err = repository.ExportEventsForEntity(context.Background(), testSourceIdentity, testTickNumber, testTickNumber, collect)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(rows))
+	assert.Equal(t, uint32(testTickNumber), rows[0].Tick)
+	assert.Equal(t, "outgoing", rows[0].Direction)
+	assert.Equal(t, testDestinationEntity, rows[0].Counterparty)
+	assert.Equal(t, uint64(42), rows[0].Amount)
+	assert.Empty(t, rows[0].Asset)
+
+	rows = nil
+	err = repository.ExportEventsForEntity(context.Background(), testDestinationEntity, 0, 0, collect)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(rows))
+	assert.Equal(t, "incoming", rows[0].Direction)
+
+	rows = nil
+	err = repository.ExportEventsForEntity(context.Background(), testSourceIdentity, testTickNumber+1, 0, collect)
+	assert.Nil(t, err)
+	assert.Empty(t, rows)
+
+	deleteTransferQuEvent(transferId, t)
+	cleanupEventTestData(t, transactionId, tickId, eventId)
+	deleteEntity(sourceEntityId, t)
+	deleteEntity(destinationEntityId, t)
+}
@@ -3,6 +3,8 @@ package db
 import (
 	"context"
 	"database/sql"
+	"strconv"
+
 	_ "github.com/lib/pq"
 	"github.com/pkg/errors"
 )
@@ -19,12 +21,12 @@ func (r *PgRepository) GetOrCreateEvent(ctx context.Context, transactionId int,
 
 func (r *PgRepository) insertEvent(ctx context.Context, transactionId int, eventEventId uint64, eventType uint32, eventData string) (int, error) {
 	insertSql := `insert into events (transaction_id, event_id, event_type, event_data) values ($1, $2, $3, $4) returning id;`
-	return insert(ctx, r.db, insertSql, transactionId, eventEventId, eventType, eventData)
+	return insert(ctx, r.conn(ctx), insertSql, transactionId, eventEventId, eventType, eventData)
 }
 
 func (r *PgRepository) getEventId(ctx context.Context, transactionId int, eventEventId uint64) (int, error) {
 	selectSql := `select id from events where transaction_id = $1 and event_id = $2;`
-	return getId(ctx, r.db, selectSql, transactionId, eventEventId)
+	return getId(ctx, r.conn(ctx), selectSql, transactionId, eventEventId)
 }
 
 // qu transfer events
@@ -39,17 +41,18 @@ func (r *PgRepository) GetOrCreateQuTransferEvent(ctx context.Context, eventId i
 
 func (r *PgRepository) insertQuTransferEvent(ctx context.Context, eventId int, sourceEntityId int, destinationEntityId int, amount uint64) (int, error) {
 	insertSql := `insert into qu_transfer_events (event_id, source_entity_id, destination_entity_id, amount) values ($1, $2, $3, $4) returning id;`
-	return insert(ctx, r.db, insertSql, eventId, sourceEntityId, destinationEntityId, amount)
+	// the driver does not support uint64 values above the int64 range
+	return insert(ctx, r.conn(ctx), insertSql, eventId, sourceEntityId, destinationEntityId, strconv.FormatUint(amount, 10))
 }
 
 func (r *PgRepository) getQuTransferEventId(ctx context.Context, eventId int) (int, error) {
 	selectSql := `select id from qu_transfer_events where event_id = $1;`
-	return getId(ctx, r.db, selectSql, eventId)
+	return getId(ctx, r.conn(ctx), selectSql, eventId)
 }
 
 // asset change events
 
-func (r *PgRepository) GetOrCreateAssetChangeEvent(ctx context.Context, eventId, assetId, sourceEntityId, destinationEntityId int, numberOfShares int64) (int, error) {
+func (r *PgRepository) GetOrCreateAssetChangeEvent(ctx context.Context, eventId, assetId, sourceEntityId, destinationEntityId int, numberOfShares uint64) (int, error) {
 	id, err := r.getAssetChangeEventId(ctx, eventId)
 	if errors.Is(err, sql.ErrNoRows) {
 		id, err = r.insertAssetChangeEvent(ctx, eventId, assetId, sourceEntityId, destinationEntityId, numberOfShares)
@@ -57,14 +60,14 @@ func (r *PgRepository) GetOrCreateAssetChangeEvent(ctx context.Context, eventId,
 	return id, errors.Wrapf(err, "getting or creating asset change for event [%d]", eventId)
 }
 
-func (r *PgRepository) insertAssetChangeEvent(ctx context.Context, eventId, assetId, sourceEntityId, destinationEntityId int, numberOfShares int64) (int, error) {
+func (r *PgRepository) insertAssetChangeEvent(ctx context.Context, eventId, assetId, sourceEntityId, destinationEntityId int, numberOfShares uint64) (int, error) {
 	insertSql := `insert into asset_change_events (event_id, asset_id, source_entity_id, destination_entity_id, number_of_shares) values ($1, $2, $3, $4, $5) returning id;`
-	return insert(ctx, r.db, insertSql, eventId, assetId, sourceEntityId, destinationEntityId, numberOfShares)
+	return insert(ctx, r.conn(ctx), insertSql, eventId, assetId, sourceEntityId, destinationEntityId, strconv.FormatUint(numberOfShares, 10))
 }
 
 func (r *PgRepository) getAssetChangeEventId(ctx context.Context, eventId int) (int, error) {
 	selectSql := `select id from asset_change_events where event_id = $1;`
-	return getId(ctx, r.db, selectSql, eventId)
+	return getId(ctx, r.conn(ctx), selectSql, eventId)
 }
 
 // asset issuance events
@@ -79,10 +82,10 @@ func (r *PgRepository) GetOrCreateAssetIssuanceEvent(ctx context.Context, eventI
 
 func (r *PgRepository) insertAssetIssuanceEvent(ctx context.Context, eventId int, assetId int, numberOfShares int64, unitOfMeasurement string, numberOfDecimalPlaces uint32) (int, error) {
 	insertSql := `insert into asset_issuance_events (event_id, asset_id, number_of_shares, unit_of_measurement, number_of_decimal_places) VALUES ($1, $2, $3, $4, $5) returning id;`
-	return insert(ctx, r.db, insertSql, eventId, assetId, numberOfShares, unitOfMeasurement, numberOfDecimalPlaces)
+	return insert(ctx, r.conn(ctx), insertSql, eventId, assetId, numberOfShares, unitOfMeasurement, numberOfDecimalPlaces)
 }
 
 func (r *PgRepository) getAssetIssuanceEventId(ctx context.Context, eventId int) (int, error) {
 	selectSql := `select id from asset_issuance_events where event_id = $1;`
-	return getId(ctx, r.db, selectSql, eventId)
+	return getId(ctx, r.conn(ctx), selectSql, eventId)
 }
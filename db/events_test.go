@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -50,6 +51,24 @@ func TestPgRepository_GetOrCreateQuTransferEvent_GivenNoTransferEvent_ThenCreate
 	deleteEntity(destinationEntityId, t)
 }
 
+func TestPgRepository_GetOrCreateQuTransferEvent_GivenAmountAboveInt64_ThenCreate(t *testing.T) {
+	tickId, transactionId, eventId := setupEventTestData(t, 0)
+	sourceEntityId, destinationEntityId := setupSourceAndDestinationEntity(t)
+
+	transferId, err := repository.GetOrCreateQuTransferEvent(context.Background(), eventId, sourceEntityId, destinationEntityId, math.MaxUint64)
+	assert.Nil(t, err)
+	events, err := repository.GetQuTransferEventsForTick(context.Background(), testTickNumber)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, uint64(math.MaxUint64), events[0].GetAmount())
+
+	// clean up
+	deleteTransferQuEvent(transferId, t)
+	cleanupEventTestData(t, transactionId, tickId, eventId)
+	deleteEntity(sourceEntityId, t)
+	deleteEntity(destinationEntityId, t)
+}
+
 func TestPgRepository_GetOrCreateQuTransferEvent_GivenTransferEvent_ThenGet(t *testing.T) {
 	tickId, transactionId, eventId := setupEventTestData(t, 0)
 	sourceEntityId, destinationEntityId := setupSourceAndDestinationEntity(t)
@@ -0,0 +1,100 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"go-transfers/proto"
+
+	_ "github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// asset holdings (ownership and possession)
+
+func (r *PgRepository) UpdateAssetOwnership(ctx context.Context, eventId, assetId, entityId int, delta Delta) error {
+	return errors.Wrapf(r.updateAssetHolding(ctx, "asset_ownerships", eventId, assetId, entityId, delta),
+		"updating asset ownership for asset [%d] and entity [%d]", assetId, entityId)
+}
+
+func (r *PgRepository) UpdateAssetPossession(ctx context.Context, eventId, assetId, entityId int, delta Delta) error {
+	return errors.Wrapf(r.updateAssetHolding(ctx, "asset_possessions", eventId, assetId, entityId, delta),
+		"updating asset possession for asset [%d] and entity [%d]", assetId, entityId)
+}
+
+// updateAssetHolding adds the delta to the holding. Changes of events, that are already applied, are ignored.
+func (r *PgRepository) updateAssetHolding(ctx context.Context, table string, eventId, assetId, entityId int, delta Delta) error {
+	upsertSql := fmt.Sprintf(`insert into %s as h (asset_id, entity_id, number_of_shares, last_event_id) 
+		values ($1, $2, $3::numeric, $4)
+		on conflict (asset_id, entity_id) do update
+		set number_of_shares = h.number_of_shares + excluded.number_of_shares, last_event_id = excluded.last_event_id
+		where h.last_event_id < excluded.last_event_id;`, table)
+	_, err := r.conn(ctx).ExecContext(ctx, upsertSql, assetId, entityId, delta.numeric(), eventId)
+	return err
+}
+
+func (r *PgRepository) GetAssetHoldingsForEntity(ctx context.Context, identity string) ([]*proto.AssetHolding, error) {
+	selectSql := `with owned as (select o.asset_id, o.number_of_shares
+       			from asset_ownerships o
+       			join entities en on o.entity_id = en.id
+       			where en.identity = $1),
+     		possessed as (select p.asset_id, p.number_of_shares
+       			from asset_possessions p
+       			join entities en on p.entity_id = en.id
+       			where en.identity = $1)
+		select issuer.identity issuerId,
+       		a.name,
+       		greatest(coalesce(owned.number_of_shares, 0), 0) ownedShares,
+       		greatest(coalesce(possessed.number_of_shares, 0), 0) possessedShares,
+       		coalesce(a.number_of_decimals, 0) numberOfDecimals
+		from owned
+		full outer join possessed on owned.asset_id = possessed.asset_id
+		join assets a on a.id = coalesce(owned.asset_id, possessed.asset_id)
+		join entities issuer on a.issuer_id = issuer.id
+		where owned.number_of_shares > 0 or possessed.number_of_shares > 0
+		order by issuer.identity, a.name;`
+	var holdings []*proto.AssetHolding
+	err := r.db.SelectContext(ctx, &holdings, selectSql, identity)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting asset holdings")
+	}
+	for _, holding := range holdings {
+		holding.FormattedOwnedShares = FormatAmount(holding.GetOwnedShares(), holding.GetNumberOfDecimals())
+		holding.FormattedPossessedShares = FormatAmount(holding.GetPossessedShares(), holding.GetNumberOfDecimals())
+	}
+	return holdings, nil
+}
+
+func (r *PgRepository) GetAssetHolders(ctx context.Context, issuer, name string) ([]*proto.AssetHolder, error) {
+	selectSql := `with asset as (select a.id
+       			from assets a
+       			join entities issuer on a.issuer_id = issuer.id
+       			where issuer.identity = $1 and a.name = $2),
+     		owned as (select o.entity_id, o.number_of_shares
+       			from asset_ownerships o
+       			where o.asset_id = (select id from asset)),
+     		possessed as (select p.entity_id, p.number_of_shares
+       			from asset_possessions p
+       			where p.asset_id = (select id from asset))
+		select en.identity,
+       		greatest(coalesce(owned.number_of_shares, 0), 0) ownedShares,
+       		greatest(coalesce(possessed.number_of_shares, 0), 0) possessedShares
+		from owned
+		full outer join possessed on owned.entity_id = possessed.entity_id
+		join entities en on en.id = coalesce(owned.entity_id, possessed.entity_id)
+		where owned.number_of_shares > 0 or possessed.number_of_shares > 0
+		order by ownedShares desc, possessedShares desc, en.identity;`
+	var holders []*proto.AssetHolder
+	err := r.db.SelectContext(ctx, &holders, selectSql, issuer, name)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting asset holders")
+	}
+	decimals, err := r.getAssetDecimals(ctx, issuer, name)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting asset decimals")
+	}
+	for _, holder := range holders {
+		holder.FormattedOwnedShares = FormatAmount(holder.GetOwnedShares(), decimals)
+		holder.FormattedPossessedShares = FormatAmount(holder.GetPossessedShares(), decimals)
+	}
+	return holders, nil
+}
@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"go-transfers/proto"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func deleteAssetHoldings(assetId int, t *testing.T) {
+	_, err := repository.delete(`delete from asset_ownerships where asset_id = $1;`, assetId)
+	assert.Nil(t, err)
+	_, err = repository.delete(`delete from asset_possessions where asset_id = $1;`, assetId)
+	assert.Nil(t, err)
+}
+
+func TestPgRepository_UpdateAssetHoldings_ThenGetHoldingsAndHolders(t *testing.T) {
+	tickId, transactionId, eventId := setupEventTestData(t, 2)
+	sourceEntityId, destinationEntityId := setupSourceAndDestinationEntity(t)
+	assetId, err := repository.GetOrCreateAsset(context.Background(), AAA, "TESTAS")
+	assert.Nil(t, err)
+	assert.Nil(t, repository.UpdateAssetDecimals(context.Background(), assetId, 1, ""))
+
+	assert.Nil(t, repository.UpdateAssetOwnership(context.Background(), eventId, assetId, sourceEntityId, Debit(10)))
+	assert.Nil(t, repository.UpdateAssetOwnership(context.Background(), eventId, assetId, destinationEntityId, Credit(10)))
+	assert.Nil(t, repository.UpdateAssetOwnership(context.Background(), eventId, assetId, destinationEntityId, Credit(10))) // ignored
+	assert.Nil(t, repository.UpdateAssetPossession(context.Background(), eventId, assetId, destinationEntityId, Credit(7)))
+
+	holdings, err := repository.GetAssetHoldingsForEntity(context.Background(), testDestinationEntity)
+	assert.Nil(t, err)
+	assert.Equal(t, []*proto.AssetHolding{{
+		IssuerId:                 AAA,
+		Name:                     "TESTAS",
+		OwnedShares:              10,
+		PossessedShares:          7,
+		NumberOfDecimals:         1,
+		FormattedOwnedShares:     "1.0",
+		FormattedPossessedShares: "0.7",
+	}}, holdings)
+
+	holdings, err = repository.GetAssetHoldingsForEntity(context.Background(), testSourceIdentity)
+	assert.Nil(t, err)
+	assert.Empty(t, holdings) // negative holdings are not returned
+
+	holders, err := repository.GetAssetHolders(context.Background(), AAA, "TESTAS")
+	assert.Nil(t, err)
+	assert.Equal(t, []*proto.AssetHolder{{
+		Identity:                 testDestinationEntity,
+		OwnedShares:              10,
+		PossessedShares:          7,
+		FormattedOwnedShares:     "1.0",
+		FormattedPossessedShares: "0.7",
+	}}, holders)
+
+	// clean up
+	deleteAssetHoldings(assetId, t)
+	deleteAsset(assetId, t)
+	cleanupEventTestData(t, transactionId, tickId, eventId)
+	deleteEntity(sourceEntityId, t)
+	deleteEntity(destinationEntityId, t)
+}
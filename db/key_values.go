@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 	"github.com/pkg/errors"
 )
@@ -13,7 +14,15 @@ func (r *PgRepository) GetLatestTick(ctx context.Context) (int, error) {
 }
 
 func (r *PgRepository) UpdateLatestTick(ctx context.Context, tickNumber int) error {
-	return r.updateNumericValue(ctx, "tick", tickNumber)
+	return updateNumericValue(ctx, r.conn(ctx), "tick", tickNumber)
+}
+
+func (r *PgRepository) GetEpochStartTick(ctx context.Context) (int, error) {
+	return r.getNumericValue(ctx, "epoch_start_tick")
+}
+
+func (r *PgRepository) UpdateEpochStartTick(ctx context.Context, tickNumber int) error {
+	return updateNumericValue(ctx, r.conn(ctx), "epoch_start_tick", tickNumber)
 }
 
 func (r *PgRepository) getNumericValue(ctx context.Context, key string) (int, error) {
@@ -23,8 +32,8 @@ func (r *PgRepository) getNumericValue(ctx context.Context, key string) (int, er
 	return value, errors.Wrap(err, "getting numeric value")
 }
 
-func (r *PgRepository) updateNumericValue(ctx context.Context, key string, value int) error {
+func updateNumericValue(ctx context.Context, db sqlx.ExecerContext, key string, value int) error {
 	updateSql := `update key_values set numeric_value = $1 where key = $2`
-	_, err := r.db.ExecContext(ctx, updateSql, value, key)
+	_, err := db.ExecContext(ctx, updateSql, value, key)
 	return errors.Wrap(err, "updating numeric value")
 }
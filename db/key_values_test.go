@@ -24,3 +24,16 @@ func TestPgRepository_UpdatedNumericValue(t *testing.T) {
 
 	_ = repository.UpdateLatestTick(context.Background(), original) // clean up
 }
+
+func TestPgRepository_UpdateEpochStartTick(t *testing.T) {
+	original, err := repository.GetEpochStartTick(context.Background())
+	assert.Nil(t, err)
+
+	err = repository.UpdateEpochStartTick(context.Background(), 4242)
+	assert.Nil(t, err)
+	updated, err := repository.GetEpochStartTick(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 4242, updated)
+
+	_ = repository.UpdateEpochStartTick(context.Background(), original) // clean up
+}
@@ -0,0 +1,2 @@
+alter table ticks
+    drop column if exists timestamp;
\ No newline at end of file
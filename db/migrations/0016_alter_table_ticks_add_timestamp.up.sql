@@ -0,0 +1,4 @@
+alter table ticks
+    add column if not exists timestamp timestamp with time zone; -- on-chain tick time. null for ticks stored before.
+
+create index on ticks(timestamp);
@@ -0,0 +1 @@
+drop table if exists entity_qu_balances;
\ No newline at end of file
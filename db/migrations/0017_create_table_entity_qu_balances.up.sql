@@ -0,0 +1,30 @@
+create table if not exists entity_qu_balances (
+    id bigint primary key generated by default as identity,
+    event_id bigint references events(id) not null,
+    entity_id bigint references entities(id) not null,
+    delta bigint not null,
+    balance bigint not null, -- running total of the tracked transfers (without AAA transfers)
+    created_at timestamp with time zone default now() not null,
+    unique (event_id, entity_id)
+);
+
+create index on entity_qu_balances(entity_id);
+
+-- backfill already stored transfers in event order. ids need to be ascending per entity for the running total.
+insert into entity_qu_balances (event_id, entity_id, delta, balance)
+select event_id, entity_id, delta,
+       sum(delta) over (partition by entity_id order by tick_number, event_event_id rows unbounded preceding)
+from (select e.id event_id, ev.source_entity_id entity_id, -ev.amount delta, ti.tick_number, e.event_id event_event_id
+      from qu_transfer_events ev
+      join events e on ev.event_id = e.id
+      join transactions tx on e.transaction_id = tx.id
+      join ticks ti on tx.tick_id = ti.id
+      where ev.source_entity_id <> ev.destination_entity_id
+      union all
+      select e.id, ev.destination_entity_id, ev.amount, ti.tick_number, e.event_id
+      from qu_transfer_events ev
+      join events e on ev.event_id = e.id
+      join transactions tx on e.transaction_id = tx.id
+      join ticks ti on tx.tick_id = ti.id
+      where ev.source_entity_id <> ev.destination_entity_id) changes
+order by tick_number, event_event_id;
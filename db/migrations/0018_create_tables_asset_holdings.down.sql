@@ -0,0 +1,2 @@
+drop table if exists asset_possessions;
+drop table if exists asset_ownerships;
\ No newline at end of file
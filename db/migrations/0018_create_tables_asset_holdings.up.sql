@@ -0,0 +1,65 @@
+-- current asset holdings derived from the tracked asset change events
+
+create table if not exists asset_ownerships (
+    id bigint primary key generated by default as identity,
+    asset_id bigint references assets(id) not null,
+    entity_id bigint references entities(id) not null,
+    number_of_shares bigint not null,
+    last_event_id bigint references events(id) not null, -- latest applied change. changes must not be applied twice.
+    updated_at timestamp with time zone default now() not null,
+    created_at timestamp with time zone default now() not null,
+    unique (asset_id, entity_id)
+);
+
+create index on asset_ownerships(entity_id);
+
+create trigger trigger_asset_ownerships_updated_at
+    before update on asset_ownerships
+    for each row execute procedure
+    set_updated_at_time();
+
+create table if not exists asset_possessions (
+    id bigint primary key generated by default as identity,
+    asset_id bigint references assets(id) not null,
+    entity_id bigint references entities(id) not null,
+    number_of_shares bigint not null,
+    last_event_id bigint references events(id) not null, -- latest applied change. changes must not be applied twice.
+    updated_at timestamp with time zone default now() not null,
+    created_at timestamp with time zone default now() not null,
+    unique (asset_id, entity_id)
+);
+
+create index on asset_possessions(entity_id);
+
+create trigger trigger_asset_possessions_updated_at
+    before update on asset_possessions
+    for each row execute procedure
+    set_updated_at_time();
+
+-- backfill already stored changes (event type 2: ownership change, 3: possession change)
+
+insert into asset_ownerships (asset_id, entity_id, number_of_shares, last_event_id)
+select asset_id, entity_id, sum(delta), max(event_id)
+from (select ev.asset_id, ev.source_entity_id entity_id, -ev.number_of_shares delta, ev.event_id
+      from asset_change_events ev
+      join events e on ev.event_id = e.id
+      where e.event_type = 2 and ev.source_entity_id <> ev.destination_entity_id
+      union all
+      select ev.asset_id, ev.destination_entity_id, ev.number_of_shares, ev.event_id
+      from asset_change_events ev
+      join events e on ev.event_id = e.id
+      where e.event_type = 2 and ev.source_entity_id <> ev.destination_entity_id) changes
+group by asset_id, entity_id;
+
+insert into asset_possessions (asset_id, entity_id, number_of_shares, last_event_id)
+select asset_id, entity_id, sum(delta), max(event_id)
+from (select ev.asset_id, ev.source_entity_id entity_id, -ev.number_of_shares delta, ev.event_id
+      from asset_change_events ev
+      join events e on ev.event_id = e.id
+      where e.event_type = 3 and ev.source_entity_id <> ev.destination_entity_id
+      union all
+      select ev.asset_id, ev.destination_entity_id, ev.number_of_shares, ev.event_id
+      from asset_change_events ev
+      join events e on ev.event_id = e.id
+      where e.event_type = 3 and ev.source_entity_id <> ev.destination_entity_id) changes
+group by asset_id, entity_id;
@@ -0,0 +1,50 @@
+create table if not exists webhooks (
+    id bigint primary key generated by default as identity,
+    url text not null,
+    secret text not null, -- key for signing the payload
+    identities text[] not null default '{}', -- source or destination. empty matches all.
+    assets text[] not null default '{}', -- ISSUER/NAME. only asset changes match. empty matches all.
+    event_types smallint[] not null default '{}', -- empty matches all.
+    min_amount bigint not null default 0, -- amount or number of shares
+    enabled boolean not null default true,
+    updated_at timestamp with time zone default now() not null,
+    created_at timestamp with time zone default now() not null
+);
+
+create trigger trigger_webhooks_updated_at
+    before update on webhooks
+    for each row execute procedure
+    set_updated_at_time();
+
+-- notifications are queued in the transaction that commits the tick and delivered asynchronously
+create table if not exists webhook_outbox (
+    id bigint primary key generated by default as identity,
+    webhook_id bigint references webhooks(id) on delete cascade not null,
+    event_id bigint references events(id) not null,
+    payload jsonb not null,
+    status text not null default 'pending', -- pending, delivered, failed
+    attempts int not null default 0,
+    next_attempt_at timestamp with time zone default now() not null,
+    updated_at timestamp with time zone default now() not null,
+    created_at timestamp with time zone default now() not null,
+    unique (webhook_id, event_id)
+);
+
+create index on webhook_outbox(next_attempt_at) where status = 'pending';
+
+create trigger trigger_webhook_outbox_updated_at
+    before update on webhook_outbox
+    for each row execute procedure
+    set_updated_at_time();
+
+create table if not exists webhook_deliveries (
+    id bigint primary key generated by default as identity,
+    outbox_id bigint references webhook_outbox(id) on delete cascade not null,
+    attempt int not null,
+    status_code int not null, -- 0, if there was no response
+    error text not null default '',
+    duration_ms bigint not null,
+    created_at timestamp with time zone default now() not null
+);
+
+create index on webhook_deliveries(outbox_id);
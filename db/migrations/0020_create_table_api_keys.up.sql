@@ -0,0 +1,17 @@
+create table if not exists api_keys (
+    id bigint primary key generated by default as identity,
+    name text not null,
+    key_hash text not null unique, -- hex encoded sha256 of the key. the key itself is not stored.
+    key_prefix text not null, -- to identify the key
+    requests_per_second double precision not null default 0, -- 0 means unlimited
+    burst int not null default 0,
+    daily_quota bigint not null default 0, -- 0 means unlimited
+    enabled boolean not null default true,
+    updated_at timestamp with time zone default now() not null,
+    created_at timestamp with time zone default now() not null
+);
+
+create trigger trigger_api_keys_updated_at
+    before update on api_keys
+    for each row execute procedure
+    set_updated_at_time();
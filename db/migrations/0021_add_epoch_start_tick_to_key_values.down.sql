@@ -0,0 +1 @@
+delete from key_values where key = 'epoch_start_tick';
\ No newline at end of file
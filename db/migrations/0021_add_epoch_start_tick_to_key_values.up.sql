@@ -0,0 +1 @@
+insert into key_values(key, numeric_value) VALUES ('epoch_start_tick', 0); -- initial tick of the current epoch
\ No newline at end of file
@@ -0,0 +1,2 @@
+alter table ticks
+    drop column if exists epoch;
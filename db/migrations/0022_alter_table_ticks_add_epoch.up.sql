@@ -0,0 +1,2 @@
+alter table ticks
+    add column if not exists epoch integer; -- null for ticks stored before.
@@ -0,0 +1,21 @@
+alter table asset_possessions
+    alter column number_of_shares type bigint;
+
+alter table asset_ownerships
+    alter column number_of_shares type bigint;
+
+alter table entity_qu_balances
+    alter column balance type bigint,
+    alter column delta type bigint;
+
+alter table asset_change_events
+    drop constraint if exists asset_change_events_number_of_shares_check,
+    alter column number_of_shares type bigint;
+
+alter table qu_transfer_events
+    drop constraint if exists qu_transfer_events_amount_check,
+    alter column amount type bigint;
+
+alter table assets
+    drop column if exists unit_of_measurement,
+    drop column if exists number_of_decimals;
@@ -0,0 +1,23 @@
+-- decimals and measurement unit are part of the asset change events. null, if no change is stored yet.
+alter table assets
+    add column if not exists number_of_decimals smallint check (number_of_decimals >= 0),
+    add column if not exists unit_of_measurement text; -- base64 encoded
+
+-- amounts and shares are unsigned on chain. numeric(39) fits any 128 bit value and sums of it do not overflow.
+alter table qu_transfer_events
+    alter column amount type numeric(39),
+    add constraint qu_transfer_events_amount_check check (amount >= 0);
+
+alter table asset_change_events
+    alter column number_of_shares type numeric(39),
+    add constraint asset_change_events_number_of_shares_check check (number_of_shares >= 0);
+
+alter table entity_qu_balances
+    alter column delta type numeric(39),
+    alter column balance type numeric(39);
+
+alter table asset_ownerships
+    alter column number_of_shares type numeric(39);
+
+alter table asset_possessions
+    alter column number_of_shares type numeric(39);
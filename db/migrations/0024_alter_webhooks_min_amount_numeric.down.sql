@@ -0,0 +1,3 @@
+alter table webhooks
+    drop constraint if exists webhooks_min_amount_check,
+    alter column min_amount type bigint;
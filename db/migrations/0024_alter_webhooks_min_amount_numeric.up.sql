@@ -0,0 +1,4 @@
+-- amounts and shares are unsigned 64 bit values on chain, that don't fit bigint.
+alter table webhooks
+    alter column min_amount type numeric(39),
+    add constraint webhooks_min_amount_check check (min_amount >= 0);
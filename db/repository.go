@@ -2,9 +2,12 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"github.com/gookit/slog"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
+	"github.com/pkg/errors"
+	"time"
 )
 
 type PgRepository struct {
@@ -16,20 +19,60 @@ func NewRepository(db *sqlx.DB) *PgRepository {
 	return &repo
 }
 
+// queryer is implemented by the database and by transactions.
+type queryer interface {
+	sqlx.ExtContext
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+}
+
+type transactionKey struct{}
+
+// InTransaction runs the function in one transaction, that is committed, if the function succeeds. Repository calls
+// with the context, that is passed to the function, take part in the transaction. Nested calls join the transaction.
+func (r *PgRepository) InTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(transactionKey{}).(*sqlx.Tx); ok {
+		return fn(ctx)
+	}
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "starting transaction")
+	}
+	defer func() { _ = tx.Rollback() }() // no-op after commit
+
+	err = fn(context.WithValue(ctx, transactionKey{}, tx))
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(tx.Commit(), "committing transaction")
+}
+
+// conn returns the transaction of the context, if there is one, or the database.
+func (r *PgRepository) conn(ctx context.Context) queryer {
+	if tx, ok := ctx.Value(transactionKey{}).(*sqlx.Tx); ok {
+		return tx
+	}
+	return r.db
+}
+
 // helper methods
 
-func getId(ctx context.Context, db *sqlx.DB, statement string, args ...interface{}) (int, error) {
+func getId(ctx context.Context, db queryer, statement string, args ...interface{}) (int, error) {
 	var id int
 	err := db.GetContext(ctx, &id, statement, args...)
 	return id, err
 }
 
-func insert(ctx context.Context, db *sqlx.DB, statement string, args ...interface{}) (int, error) {
+func insert(ctx context.Context, db queryer, statement string, args ...interface{}) (int, error) {
 	var id int
 	err := db.GetContext(ctx, &id, statement, args...)
 	return id, err
 }
 
+// nullTime maps the zero time to null, so that optional timestamps are stored as null.
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
 func (r *PgRepository) Close() {
 	err := r.db.Close()
 	if err != nil {
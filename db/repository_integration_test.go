@@ -2,9 +2,11 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"flag"
 	"github.com/gookit/slog"
 	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
@@ -17,10 +19,12 @@ import (
 var (
 	repository        *PgRepository
 	postgresContainer testcontainers.Container
+	testTickTime      = time.Date(2025, time.March, 14, 12, 30, 0, 0, time.UTC)
 )
 
 const (
 	testTickNumber        = 42
+	testEpoch             = 150
 	AAA                   = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFXIB"
 	testSourceIdentity    = "SOURCE_IDENTITY"
 	testDestinationEntity = "TARGET_IDENTITY"
@@ -30,7 +34,7 @@ const (
 // test data set-ups and clean-ups
 
 func setupTransactionTestData(t *testing.T) (int, int) {
-	tickId, err := repository.GetOrCreateTick(context.Background(), testTickNumber)
+	tickId, err := repository.GetOrCreateTick(context.Background(), testTickNumber, testTickTime, testEpoch)
 	assert.Nil(t, err)
 	transactionId, err := repository.GetOrCreateTransaction(context.Background(), testTransactionHash, tickId)
 	assert.Nil(t, err)
@@ -177,3 +181,37 @@ func setupDatabase() (string, error) {
 	}
 	return postgresContainer.ConnectionString(ctx, "sslmode=disable")
 }
+
+func TestPgRepository_InTransaction_givenError_thenRollback(t *testing.T) {
+	var entityId int
+	err := repository.InTransaction(context.Background(), func(ctx context.Context) error {
+		var err error
+		entityId, err = repository.GetOrCreateEntity(ctx, "ROLLED-BACK")
+		assert.Nil(t, err)
+		return repository.InTransaction(ctx, func(ctx context.Context) error { // joins
+			return errors.New("test")
+		})
+	})
+	assert.EqualError(t, err, "test")
+	assert.Greater(t, entityId, 0)
+
+	_, err = repository.getEntityId(context.Background(), "ROLLED-BACK")
+	assert.Equal(t, sql.ErrNoRows, err)
+}
+
+func TestPgRepository_InTransaction_thenCommit(t *testing.T) {
+	var entityId int
+	err := repository.InTransaction(context.Background(), func(ctx context.Context) error {
+		var err error
+		entityId, err = repository.GetOrCreateEntity(ctx, "COMMITTED")
+		return err
+	})
+	assert.Nil(t, err)
+
+	reloaded, err := repository.getEntityId(context.Background(), "COMMITTED")
+	assert.Nil(t, err)
+	assert.Equal(t, entityId, reloaded)
+
+	// clean up
+	deleteEntity(entityId, t)
+}
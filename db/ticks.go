@@ -5,22 +5,24 @@ import (
 	"database/sql"
 	_ "github.com/lib/pq"
 	"github.com/pkg/errors"
+	"time"
 )
 
-func (r *PgRepository) GetOrCreateTick(ctx context.Context, tickNumber uint32) (int, error) {
+// GetOrCreateTick returns the id of the tick. Zero timestamp or epoch are stored as null (unknown).
+func (r *PgRepository) GetOrCreateTick(ctx context.Context, tickNumber uint32, timestamp time.Time, epoch uint32) (int, error) {
 	id, err := r.getTickId(ctx, tickNumber)
 	if errors.Is(err, sql.ErrNoRows) {
-		id, err = r.insertTick(ctx, tickNumber)
+		id, err = r.insertTick(ctx, tickNumber, timestamp, epoch)
 	}
 	return id, errors.Wrapf(err, "getting or creating tick [%d]", tickNumber)
 }
 
 func (r *PgRepository) getTickId(ctx context.Context, tickNumber uint32) (int, error) {
 	selectSql := `select id from ticks where tick_number = $1;`
-	return getId(ctx, r.db, selectSql, tickNumber)
+	return getId(ctx, r.conn(ctx), selectSql, tickNumber)
 }
 
-func (r *PgRepository) insertTick(ctx context.Context, tickNumber uint32) (int, error) {
-	insertSql := `insert into ticks (tick_number) values ($1) returning id;`
-	return insert(ctx, r.db, insertSql, tickNumber)
+func (r *PgRepository) insertTick(ctx context.Context, tickNumber uint32, timestamp time.Time, epoch uint32) (int, error) {
+	insertSql := `insert into ticks (tick_number, timestamp, epoch) values ($1, $2, $3) returning id;`
+	return insert(ctx, r.conn(ctx), insertSql, tickNumber, nullTime(timestamp), sql.NullInt32{Int32: int32(epoch), Valid: epoch > 0})
 }
@@ -4,11 +4,12 @@ import (
 	"context"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 // tick
 func TestPgRepository_GetOrCreateTick_GivenNewTick_ThenCreate(t *testing.T) {
-	tickId, err := repository.GetOrCreateTick(context.Background(), 42)
+	tickId, err := repository.GetOrCreateTick(context.Background(), 42, time.Time{}, 0)
 	assert.Nil(t, err)
 	assert.Greater(t, tickId, 0)
 
@@ -17,11 +18,11 @@ func TestPgRepository_GetOrCreateTick_GivenNewTick_ThenCreate(t *testing.T) {
 }
 
 func TestPgRepository_GetOrCreateTick_GivenTick_ThenGet(t *testing.T) {
-	tickId, err := repository.insertTick(context.Background(), 42)
+	tickId, err := repository.insertTick(context.Background(), 42, time.Time{}, 0)
 	assert.Nil(t, err)
 	assert.Greater(t, tickId, 0)
 
-	reloaded, err := repository.GetOrCreateTick(context.Background(), 42)
+	reloaded, err := repository.GetOrCreateTick(context.Background(), 42, time.Time{}, 0)
 	assert.Nil(t, err)
 	assert.Equal(t, tickId, reloaded)
 
@@ -0,0 +1,61 @@
+package db
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// TransferEdge aggregates the qu transfers from one entity to another.
+type TransferEdge struct {
+	Source        string `db:"source"`
+	Destination   string `db:"destination"`
+	Amount        string `db:"amount"` // decimal sum, that may exceed uint64
+	TransferCount int64  `db:"transfer_count"`
+	FirstTick     uint32 `db:"first_tick"`
+	LastTick      uint32 `db:"last_tick"`
+}
+
+// GetTransferEdges returns the aggregated qu transfers from (or, if backward, to) the entities within the tick range
+// (inclusive, 0 means unbounded). Edges with a total amount below the minimum are skipped and only the largest edges
+// (up to fanOut) of every entity are returned. Transfers to self are ignored.
+func (r *PgRepository) GetTransferEdges(ctx context.Context, identities []string, backward bool, filter EntityFilter, minAmount uint64, fanOut int) ([]TransferEdge, error) {
+	selectSql := `with frontier as (select id from entities where identity = any($1)),
+     		edges as (select ev.source_entity_id source_id,
+       				ev.destination_entity_id destination_id,
+       				sum(ev.amount) amount,
+       				count(*) transfer_count,
+       				min(ti.tick_number) first_tick,
+       				max(ti.tick_number) last_tick
+       			from qu_transfer_events ev
+       			join events e on ev.event_id = e.id
+       			join transactions tx on e.transaction_id = tx.id
+       			join ticks ti on tx.tick_id = ti.id
+       			where e.event_type = 0
+       			and ev.source_entity_id <> ev.destination_entity_id
+       			and (case when $2 then ev.destination_entity_id else ev.source_entity_id end) in (select id from frontier)
+       			and ($3::bigint is null or ti.tick_number >= $3)
+       			and ($4::bigint is null or ti.tick_number <= $4)
+       			group by ev.source_entity_id, ev.destination_entity_id
+       			having sum(ev.amount) >= $5::numeric),
+     		ranked as (select *, row_number() over (
+       				partition by case when $2 then destination_id else source_id end
+       				order by amount desc, transfer_count desc, source_id, destination_id) rank
+       			from edges)
+		select src.identity source, dst.identity destination, ranked.amount::text amount, ranked.transfer_count,
+       		ranked.first_tick, ranked.last_tick
+		from ranked
+		join entities src on ranked.source_id = src.id
+		join entities dst on ranked.destination_id = dst.id
+		where ranked.rank <= $6
+		order by case when $2 then dst.identity else src.identity end, ranked.amount desc, ranked.rank;`
+	fromTick, toTick := filter.tickArgs()
+	var edges []TransferEdge
+	err := r.db.SelectContext(ctx, &edges, selectSql, TransferFilter{Identities: identities}.identitiesArg(), backward,
+		fromTick, toTick, strconv.FormatUint(minAmount, 10), fanOut)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting transfer edges")
+	}
+	return edges, nil
+}
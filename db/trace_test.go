@@ -0,0 +1,48 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPgRepository_GetTransferEdges(t *testing.T) {
+	tickId, transactionId, eventId := setupEventTestData(t, 0)
+	sourceEntityId, destinationEntityId := setupSourceAndDestinationEntity(t)
+	transferId, err := repository.GetOrCreateQuTransferEvent(context.Background(), eventId, sourceEntityId, destinationEntityId, 42)
+	assert.Nil(t, err)
+
+	expected := []TransferEdge{{
+		Source:        testSourceIdentity,
+		Destination:   testDestinationEntity,
+		Amount:        "42",
+		TransferCount: 1,
+		FirstTick:     testTickNumber,
+		LastTick:      testTickNumber,
+	}}
+	edges, err := repository.GetTransferEdges(context.Background(), []string{testSourceIdentity}, false, EntityFilter{}, 0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, edges)
+
+	edges, err = repository.GetTransferEdges(context.Background(), []string{testDestinationEntity}, true, EntityFilter{}, 0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, edges)
+
+	edges, err = repository.GetTransferEdges(context.Background(), []string{testDestinationEntity}, false, EntityFilter{}, 0, 10)
+	assert.Nil(t, err)
+	assert.Empty(t, edges) // no outgoing transfers
+
+	edges, err = repository.GetTransferEdges(context.Background(), []string{testSourceIdentity}, false, EntityFilter{}, 43, 10)
+	assert.Nil(t, err)
+	assert.Empty(t, edges) // below min amount
+
+	edges, err = repository.GetTransferEdges(context.Background(), []string{testSourceIdentity}, false, EntityFilter{ToTick: testTickNumber - 1}, 0, 10)
+	assert.Nil(t, err)
+	assert.Empty(t, edges)
+
+	deleteTransferQuEvent(transferId, t)
+	cleanupEventTestData(t, transactionId, tickId, eventId)
+	deleteEntity(sourceEntityId, t)
+	deleteEntity(destinationEntityId, t)
+}
@@ -17,10 +17,10 @@ func (r *PgRepository) GetOrCreateTransaction(ctx context.Context, hash string,
 
 func (r *PgRepository) getTransactionId(ctx context.Context, hash string, tickId int) (int, error) {
 	selectSql := `select id from transactions where hash = $1 and tick_id = $2;`
-	return getId(ctx, r.db, selectSql, hash, tickId)
+	return getId(ctx, r.conn(ctx), selectSql, hash, tickId)
 }
 
 func (r *PgRepository) insertTransaction(ctx context.Context, hash string, tickId int) (int, error) {
 	insertSql := `insert into transactions (hash, tick_id) values ($1, $2) returning id;`
-	return insert(ctx, r.db, insertSql, hash, tickId)
+	return insert(ctx, r.conn(ctx), insertSql, hash, tickId)
 }
@@ -4,11 +4,12 @@ import (
 	"context"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 // transaction
 func TestPgRepository_GetOrCreateTransaction_GivenNoTransaction_ThenInsert(t *testing.T) {
-	tickId, err := repository.GetOrCreateTick(context.Background(), 42)
+	tickId, err := repository.GetOrCreateTick(context.Background(), 42, time.Time{}, 0)
 	assert.Nil(t, err)
 
 	transactionId, err := repository.GetOrCreateTransaction(context.Background(), "test-hash", tickId)
@@ -21,7 +22,7 @@ func TestPgRepository_GetOrCreateTransaction_GivenNoTransaction_ThenInsert(t *te
 }
 
 func TestPgRepository_GetOrCreateTransaction_GivenTransaction_ThenGet(t *testing.T) {
-	tickId, err := repository.GetOrCreateTick(context.Background(), 42)
+	tickId, err := repository.GetOrCreateTick(context.Background(), 42, time.Time{}, 0)
 	assert.Nil(t, err)
 
 	transactionId, err := repository.insertTransaction(context.Background(), "test-hash", tickId)
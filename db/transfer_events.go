@@ -0,0 +1,156 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// TransferEvent is a qu transfer or an asset change with the common event data.
+type TransferEvent struct {
+	Tick             uint32       `db:"tick"`
+	Epoch            uint32       `db:"epoch"` // 0, if unknown
+	Timestamp        sql.NullTime `db:"timestamp"`
+	TransactionHash  string       `db:"transaction_hash"`
+	EventId          uint64       `db:"event_id"`
+	EventType        uint32       `db:"event_type"`
+	Source           string       `db:"source"`
+	Destination      string       `db:"destination"`
+	Amount           uint64       `db:"amount"`             // qu or number of shares
+	Issuer           string       `db:"issuer"`             // empty for qu
+	Name             string       `db:"name"`               // empty for qu
+	NumberOfDecimals uint32       `db:"number_of_decimals"` // 0 for qu
+}
+
+// GetTransferEventsForTick returns the qu transfers and asset changes of the tick in event order.
+func (r *PgRepository) GetTransferEventsForTick(ctx context.Context, tickNumber uint32) ([]*TransferEvent, error) {
+	selectSql := `with transfers as (select ev.event_id, ev.source_entity_id, ev.destination_entity_id, ev.amount, null::bigint asset_id
+       			from qu_transfer_events ev
+       			union all
+       			select ev.event_id, ev.source_entity_id, ev.destination_entity_id, ev.number_of_shares, ev.asset_id
+       			from asset_change_events ev)
+		select ti.tick_number tick,
+       		coalesce(ti.epoch, 0) epoch,
+       		ti.timestamp,
+       		tx.hash transaction_hash,
+       		e.event_id,
+       		e.event_type,
+       		src.identity source,
+       		dst.identity destination,
+       		t.amount,
+       		coalesce(issuer.identity, '') issuer,
+       		coalesce(a.name, '') name,
+       		coalesce(a.number_of_decimals, 0) number_of_decimals
+		from transfers t
+		join events e on t.event_id = e.id
+		join transactions tx on e.transaction_id = tx.id
+		join ticks ti on tx.tick_id = ti.id
+		join entities src on t.source_entity_id = src.id
+		join entities dst on t.destination_entity_id = dst.id
+		left join assets a on t.asset_id = a.id
+		left join entities issuer on a.issuer_id = issuer.id
+		where ti.tick_number = $1
+		and ((t.asset_id is null and e.event_type = 0) or (t.asset_id is not null and e.event_type in (2, 3)))
+		order by e.event_id;`
+	var events []*TransferEvent
+	err := r.db.SelectContext(ctx, &events, selectSql, tickNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting transfer events")
+	}
+	return events, nil
+}
+
+// GetTransferEventsForTransaction returns the qu transfers and asset changes of the transaction in event order.
+func (r *PgRepository) GetTransferEventsForTransaction(ctx context.Context, hash string) ([]*TransferEvent, error) {
+	selectSql := `with transfers as (select ev.event_id, ev.source_entity_id, ev.destination_entity_id, ev.amount, null::bigint asset_id
+       			from qu_transfer_events ev
+       			union all
+       			select ev.event_id, ev.source_entity_id, ev.destination_entity_id, ev.number_of_shares, ev.asset_id
+       			from asset_change_events ev)
+		select ti.tick_number tick,
+       		coalesce(ti.epoch, 0) epoch,
+       		ti.timestamp,
+       		tx.hash transaction_hash,
+       		e.event_id,
+       		e.event_type,
+       		src.identity source,
+       		dst.identity destination,
+       		t.amount,
+       		coalesce(issuer.identity, '') issuer,
+       		coalesce(a.name, '') name,
+       		coalesce(a.number_of_decimals, 0) number_of_decimals
+		from transfers t
+		join events e on t.event_id = e.id
+		join transactions tx on e.transaction_id = tx.id
+		join ticks ti on tx.tick_id = ti.id
+		join entities src on t.source_entity_id = src.id
+		join entities dst on t.destination_entity_id = dst.id
+		left join assets a on t.asset_id = a.id
+		left join entities issuer on a.issuer_id = issuer.id
+		where tx.hash = $1
+		and ((t.asset_id is null and e.event_type = 0) or (t.asset_id is not null and e.event_type in (2, 3)))
+		order by e.event_id;`
+	var events []*TransferEvent
+	err := r.db.SelectContext(ctx, &events, selectSql, hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting transfer events")
+	}
+	return events, nil
+}
+
+// GetTransferEventsForEntity returns the qu transfers and asset changes of the entity, latest first. Only events of
+// the given types are returned. No types means all types.
+func (r *PgRepository) GetTransferEventsForEntity(ctx context.Context, identity string, eventTypes []uint32, filter EntityFilter) ([]*TransferEvent, error) {
+	selectSql := `with entity as (select id from entities where identity = $1),
+     		transfers as (select ev.event_id, ev.source_entity_id, ev.destination_entity_id, ev.amount, null::bigint asset_id
+       			from qu_transfer_events ev
+       			where ev.source_entity_id = (select id from entity) or ev.destination_entity_id = (select id from entity)
+       			union all
+       			select ev.event_id, ev.source_entity_id, ev.destination_entity_id, ev.number_of_shares, ev.asset_id
+       			from asset_change_events ev
+       			where ev.source_entity_id = (select id from entity) or ev.destination_entity_id = (select id from entity))
+		select ti.tick_number tick,
+       		coalesce(ti.epoch, 0) epoch,
+       		ti.timestamp,
+       		tx.hash transaction_hash,
+       		e.event_id,
+       		e.event_type,
+       		src.identity source,
+       		dst.identity destination,
+       		t.amount,
+       		coalesce(issuer.identity, '') issuer,
+       		coalesce(a.name, '') name,
+       		coalesce(a.number_of_decimals, 0) number_of_decimals
+		from transfers t
+		join events e on t.event_id = e.id
+		join transactions tx on e.transaction_id = tx.id
+		join ticks ti on tx.tick_id = ti.id
+		join entities src on t.source_entity_id = src.id
+		join entities dst on t.destination_entity_id = dst.id
+		left join assets a on t.asset_id = a.id
+		left join entities issuer on a.issuer_id = issuer.id
+		where ((t.asset_id is null and e.event_type = 0) or (t.asset_id is not null and e.event_type in (2, 3)))
+		and (cardinality($2::integer[]) = 0 or e.event_type = any($2))
+		and ((t.destination_entity_id = (select id from entity) and $3 in (0, 1)) -- incoming
+			or (t.source_entity_id = (select id from entity) and $3 in (0, 2))) -- outgoing
+		and ($4::bigint is null or ti.tick_number >= $4)
+		and ($5::bigint is null or ti.tick_number <= $5)
+		and ($6::bigint is null or (ti.tick_number, e.event_id) < ($6, $7::bigint))
+		order by ti.tick_number desc, e.event_id desc
+		limit $8;`
+	types := make(pq.Int64Array, 0, len(eventTypes)) // nil would be null
+	for _, eventType := range eventTypes {
+		types = append(types, int64(eventType))
+	}
+	fromTick, toTick := filter.tickArgs()
+	afterTick, afterEventId := filter.cursorArgs()
+	var events []*TransferEvent
+	err := r.db.SelectContext(ctx, &events, selectSql, identity, types, filter.Direction, fromTick, toTick,
+		afterTick, afterEventId, filter.limitArg())
+	if err != nil {
+		return nil, errors.Wrap(err, "getting transfer events")
+	}
+	return events, nil
+}
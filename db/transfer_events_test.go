@@ -0,0 +1,82 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPgRepository_GetTransferEvents(t *testing.T) {
+	tickId, transactionId, eventId := setupEventTestData(t, 0)
+	assetEventId, err := repository.GetOrCreateEvent(context.Background(), transactionId, 2, 2, "bar")
+	assert.Nil(t, err)
+	sourceEntityId, destinationEntityId := setupSourceAndDestinationEntity(t)
+	assetId, err := repository.getAssetId(context.Background(), AAA, "QX") // don't clean up
+	assert.Nil(t, err)
+
+	transferId, err := repository.GetOrCreateQuTransferEvent(context.Background(), eventId, sourceEntityId, destinationEntityId, 42)
+	assert.Nil(t, err)
+	assetChangeId, err := repository.insertAssetChangeEvent(context.Background(), assetEventId, assetId, sourceEntityId, destinationEntityId, 7)
+	assert.Nil(t, err)
+
+	events, err := repository.GetTransferEventsForTick(context.Background(), testTickNumber)
+	assert.Nil(t, err)
+	require.Equal(t, 2, len(events))
+	assert.Equal(t, TransferEvent{
+		Tick:            testTickNumber,
+		Epoch:           testEpoch,
+		Timestamp:       nullTime(testTickTime),
+		TransactionHash: testTransactionHash,
+		EventId:         1,
+		EventType:       0,
+		Source:          testSourceIdentity,
+		Destination:     testDestinationEntity,
+		Amount:          42,
+	}, withUtcTimestamp(events[0]))
+	assert.Equal(t, uint64(2), events[1].EventId)
+	assert.Equal(t, AAA, events[1].Issuer)
+	assert.Equal(t, "QX", events[1].Name)
+	assert.Equal(t, uint64(7), events[1].Amount)
+
+	events, err = repository.GetTransferEventsForTransaction(context.Background(), testTransactionHash)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(events))
+
+	// latest first
+	events, err = repository.GetTransferEventsForEntity(context.Background(), testSourceIdentity, nil, EntityFilter{Limit: 1})
+	assert.Nil(t, err)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, uint64(2), events[0].EventId)
+
+	events, err = repository.GetTransferEventsForEntity(context.Background(), testSourceIdentity, nil, EntityFilter{
+		After: &Cursor{Tick: testTickNumber, EventId: 2},
+	})
+	assert.Nil(t, err)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, uint64(1), events[0].EventId)
+
+	events, err = repository.GetTransferEventsForEntity(context.Background(), testDestinationEntity, []uint32{2, 3}, EntityFilter{})
+	assert.Nil(t, err)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, uint32(2), events[0].EventType)
+
+	events, err = repository.GetTransferEventsForEntity(context.Background(), testDestinationEntity, nil, EntityFilter{Direction: 2})
+	assert.Nil(t, err)
+	assert.Empty(t, events) // no outgoing
+
+	// clean up
+	deleteAssetChangeEvent(assetChangeId, t)
+	deleteTransferQuEvent(transferId, t)
+	deleteEvent(assetEventId, t)
+	cleanupEventTestData(t, transactionId, tickId, eventId)
+	deleteEntity(sourceEntityId, t)
+	deleteEntity(destinationEntityId, t)
+}
+
+func withUtcTimestamp(event *TransferEvent) TransferEvent {
+	result := *event
+	result.Timestamp.Time = result.Timestamp.Time.UTC()
+	return result
+}
@@ -0,0 +1,213 @@
+package db
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+const (
+	WebhookStatusPending   = "pending"
+	WebhookStatusDelivered = "delivered"
+	WebhookStatusFailed    = "failed"
+)
+
+type Webhook struct {
+	Id         int64          `db:"id"`
+	Url        string         `db:"url"`
+	Secret     string         `db:"secret"`
+	Identities pq.StringArray `db:"identities"`
+	Assets     pq.StringArray `db:"assets"` // ISSUER/NAME
+	EventTypes pq.Int64Array  `db:"event_types"`
+	MinAmount  uint64         `db:"min_amount"`
+	Enabled    bool           `db:"enabled"`
+}
+
+// WebhookNotification is a queued notification with the data needed for delivery.
+type WebhookNotification struct {
+	Id       int64  `db:"id"`
+	Url      string `db:"url"`
+	Secret   string `db:"secret"`
+	Payload  []byte `db:"payload"`
+	Attempts int    `db:"attempts"`
+}
+
+type WebhookDelivery struct {
+	OutboxId   int64     `db:"outbox_id"`
+	EventId    int64     `db:"event_id"`
+	Attempt    int       `db:"attempt"`
+	StatusCode int       `db:"status_code"`
+	Error      string    `db:"error"`
+	DurationMs int64     `db:"duration_ms"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+// webhooks
+
+func (r *PgRepository) CreateWebhook(ctx context.Context, webhook Webhook) (int64, error) {
+	insertSql := `insert into webhooks (url, secret, identities, assets, event_types, min_amount)
+		values ($1, $2, $3, $4, $5, $6::numeric) returning id;`
+	var id int64
+	err := r.db.GetContext(ctx, &id, insertSql, webhook.Url, webhook.Secret, nonNullStrings(webhook.Identities),
+		nonNullStrings(webhook.Assets), nonNullInts(webhook.EventTypes), strconv.FormatUint(webhook.MinAmount, 10))
+	return id, errors.Wrap(err, "creating webhook")
+}
+
+func (r *PgRepository) GetWebhooks(ctx context.Context) ([]Webhook, error) {
+	selectSql := `select id, url, secret, identities, assets, event_types, min_amount, enabled from webhooks order by id;`
+	var webhooks []Webhook
+	err := r.db.SelectContext(ctx, &webhooks, selectSql)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting webhooks")
+	}
+	return webhooks, nil
+}
+
+// DeleteWebhook deletes the webhook with its queued notifications and returns false, if the webhook was not found.
+func (r *PgRepository) DeleteWebhook(ctx context.Context, id int64) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `delete from webhooks where id = $1;`, id)
+	if err != nil {
+		return false, errors.Wrapf(err, "deleting webhook [%d]", id)
+	}
+	count, err := result.RowsAffected()
+	return count > 0, errors.Wrapf(err, "deleting webhook [%d]", id)
+}
+
+// GetWebhookDeliveries returns the latest delivery attempts of the webhook, latest first.
+func (r *PgRepository) GetWebhookDeliveries(ctx context.Context, webhookId int64, limit int) ([]WebhookDelivery, error) {
+	selectSql := `select d.outbox_id, e.event_id, d.attempt, d.status_code, d.error, d.duration_ms, d.created_at
+		from webhook_deliveries d
+		join webhook_outbox o on d.outbox_id = o.id
+		join events e on o.event_id = e.id
+		where o.webhook_id = $1
+		order by d.id desc
+		limit $2;`
+	var deliveries []WebhookDelivery
+	err := r.db.SelectContext(ctx, &deliveries, selectSql, webhookId, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting webhook deliveries")
+	}
+	return deliveries, nil
+}
+
+// outbox
+
+// CommitTick queues the notifications of the tick's events for the matching webhooks and updates the latest
+// processed tick in one transaction. Notifications are queued only once per webhook and event.
+func (r *PgRepository) CommitTick(ctx context.Context, tickNumber int) error {
+	insertSql := `insert into webhook_outbox (webhook_id, event_id, payload)
+		select w.id, n.event_id, n.payload
+		from webhooks w
+		join (select e.id event_id,
+       			e.event_type,
+       			src.identity source,
+       			dst.identity destination,
+       			null::text asset,
+       			ev.amount,
+       			jsonb_build_object('eventType', e.event_type, 'eventId', e.event_id::text, 'tick', ti.tick_number,
+       				'transactionHash', tx.hash, 'sourceId', src.identity, 'destinationId', dst.identity,
+       				'amount', ev.amount::text) payload
+			from qu_transfer_events ev
+			join events e on ev.event_id = e.id
+			join transactions tx on e.transaction_id = tx.id
+			join ticks ti on tx.tick_id = ti.id
+			join entities src on ev.source_entity_id = src.id
+			join entities dst on ev.destination_entity_id = dst.id
+			where ti.tick_number = $1 and e.event_type = 0
+			union all
+			select e.id,
+       			e.event_type,
+       			src.identity,
+       			dst.identity,
+       			issuer.identity || '/' || a.name,
+       			ev.number_of_shares,
+       			jsonb_build_object('eventType', e.event_type, 'eventId', e.event_id::text, 'tick', ti.tick_number,
+       				'transactionHash', tx.hash, 'sourceId', src.identity, 'destinationId', dst.identity,
+       				'issuerId', issuer.identity, 'name', a.name, 'numberOfShares', ev.number_of_shares::text)
+			from asset_change_events ev
+			join events e on ev.event_id = e.id
+			join transactions tx on e.transaction_id = tx.id
+			join ticks ti on tx.tick_id = ti.id
+			join assets a on ev.asset_id = a.id
+			join entities issuer on a.issuer_id = issuer.id
+			join entities src on ev.source_entity_id = src.id
+			join entities dst on ev.destination_entity_id = dst.id
+			where ti.tick_number = $1 and e.event_type in (2, 3)) n
+		on (cardinality(w.identities) = 0 or n.source = any(w.identities) or n.destination = any(w.identities))
+		and (cardinality(w.assets) = 0 or n.asset = any(w.assets))
+		and (cardinality(w.event_types) = 0 or n.event_type = any(w.event_types))
+		and n.amount >= w.min_amount
+		where w.enabled
+		on conflict (webhook_id, event_id) do nothing;`
+
+	return r.InTransaction(ctx, func(ctx context.Context) error {
+		_, err := r.conn(ctx).ExecContext(ctx, insertSql, tickNumber)
+		if err != nil {
+			return errors.Wrapf(err, "queueing webhook notifications for tick [%d]", tickNumber)
+		}
+		return errors.Wrapf(updateNumericValue(ctx, r.conn(ctx), "tick", tickNumber), "updating latest tick to [%d]", tickNumber)
+	})
+}
+
+// ClaimDueWebhookNotifications returns pending notifications, that are due for delivery, in queue order and leases them
+// by moving the next attempt to the end of the lease. Rows locked by other dispatchers are skipped, so that every
+// notification is claimed by one dispatcher only. At most perWebhook notifications are claimed for each webhook.
+// Notifications, that are not recorded before the lease ends, are claimed again.
+func (r *PgRepository) ClaimDueWebhookNotifications(ctx context.Context, limit, perWebhook int, lease time.Duration) ([]WebhookNotification, error) {
+	claimSql := `with claimed as (
+			update webhook_outbox o set next_attempt_at = now() + make_interval(secs => $3)
+			where o.id in (select id from webhook_outbox where id in (
+					select id from (
+						select o.id, row_number() over (partition by o.webhook_id order by o.id) position
+						from webhook_outbox o
+						join webhooks w on o.webhook_id = w.id
+						where o.status = 'pending' and o.next_attempt_at <= now() and w.enabled) due
+					where position <= $2
+					order by id
+					limit $1)
+				for update skip locked)
+			returning o.id, o.webhook_id, o.payload, o.attempts)
+		select c.id, w.url, w.secret, c.payload, c.attempts
+		from claimed c
+		join webhooks w on c.webhook_id = w.id
+		order by c.id;`
+	var notifications []WebhookNotification
+	err := r.db.SelectContext(ctx, &notifications, claimSql, limit, perWebhook, lease.Seconds())
+	if err != nil {
+		return nil, errors.Wrap(err, "claiming due webhook notifications")
+	}
+	return notifications, nil
+}
+
+// RecordWebhookDelivery logs the delivery attempt and updates the status of the notification in one transaction.
+func (r *PgRepository) RecordWebhookDelivery(ctx context.Context, delivery WebhookDelivery, status string, nextAttempt time.Time) error {
+	insertSql := `insert into webhook_deliveries (outbox_id, attempt, status_code, error, duration_ms) values ($1, $2, $3, $4, $5);`
+	updateSql := `update webhook_outbox set status = $2, attempts = $3, next_attempt_at = $4 where id = $1;`
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "starting transaction")
+	}
+	defer func() { _ = tx.Rollback() }() // no-op after commit
+
+	_, err = tx.ExecContext(ctx, insertSql, delivery.OutboxId, delivery.Attempt, delivery.StatusCode, delivery.Error, delivery.DurationMs)
+	if err != nil {
+		return errors.Wrapf(err, "inserting delivery of notification [%d]", delivery.OutboxId)
+	}
+	_, err = tx.ExecContext(ctx, updateSql, delivery.OutboxId, status, delivery.Attempt, nextAttempt)
+	if err != nil {
+		return errors.Wrapf(err, "updating notification [%d]", delivery.OutboxId)
+	}
+	return errors.Wrapf(tx.Commit(), "recording delivery of notification [%d]", delivery.OutboxId)
+}
+
+func nonNullStrings(values pq.StringArray) pq.StringArray {
+	return append(make(pq.StringArray, 0, len(values)), values...) // nil would be null
+}
+
+func nonNullInts(values pq.Int64Array) pq.Int64Array {
+	return append(make(pq.Int64Array, 0, len(values)), values...) // nil would be null
+}
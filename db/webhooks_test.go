@@ -0,0 +1,103 @@
+package db
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func deleteWebhook(id int64, t *testing.T) {
+	found, err := repository.DeleteWebhook(context.Background(), id)
+	assert.Nil(t, err)
+	assert.True(t, found)
+}
+
+func TestPgRepository_CreateWebhook_ThenGetWebhooks(t *testing.T) {
+	id, err := repository.CreateWebhook(context.Background(), Webhook{
+		Url:        "https://example.org",
+		Secret:     "some-secret",
+		Identities: []string{testDestinationEntity},
+		EventTypes: []int64{0},
+		MinAmount:  math.MaxUint64,
+	})
+	assert.Nil(t, err)
+
+	webhooks, err := repository.GetWebhooks(context.Background())
+	assert.Nil(t, err)
+	assert.Contains(t, webhooks, Webhook{
+		Id:         id,
+		Url:        "https://example.org",
+		Secret:     "some-secret",
+		Identities: []string{testDestinationEntity},
+		Assets:     []string{},
+		EventTypes: []int64{0},
+		MinAmount:  math.MaxUint64,
+		Enabled:    true,
+	})
+
+	deleteWebhook(id, t)
+	found, err := repository.DeleteWebhook(context.Background(), id)
+	assert.Nil(t, err)
+	assert.False(t, found)
+}
+
+func TestPgRepository_CommitTick_ThenQueueMatchingNotifications(t *testing.T) {
+	tickId, transactionId, eventId := setupEventTestData(t, 0)
+	sourceEntityId, destinationEntityId := setupSourceAndDestinationEntity(t)
+	transferId, err := repository.GetOrCreateQuTransferEvent(context.Background(), eventId, sourceEntityId, destinationEntityId, 42)
+	assert.Nil(t, err)
+	originalTick, err := repository.GetLatestTick(context.Background())
+	assert.Nil(t, err)
+
+	matching, err := repository.CreateWebhook(context.Background(), Webhook{Url: "https://example.org", Secret: "secret", Identities: []string{testDestinationEntity}})
+	assert.Nil(t, err)
+	tooSmall, err := repository.CreateWebhook(context.Background(), Webhook{Url: "https://example.org", Secret: "secret", MinAmount: 43})
+	assert.Nil(t, err)
+	assetsOnly, err := repository.CreateWebhook(context.Background(), Webhook{Url: "https://example.org", Secret: "secret", Assets: []string{AAA + "/QX"}})
+	assert.Nil(t, err)
+
+	err = repository.CommitTick(context.Background(), testTickNumber)
+	assert.Nil(t, err)
+	err = repository.CommitTick(context.Background(), testTickNumber) // not queued twice
+	assert.Nil(t, err)
+
+	latestTick, err := repository.GetLatestTick(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, testTickNumber, latestTick)
+
+	notifications, err := repository.ClaimDueWebhookNotifications(context.Background(), 100, 10, time.Minute)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(notifications))
+	claimedAgain, err := repository.ClaimDueWebhookNotifications(context.Background(), 100, 10, time.Minute)
+	assert.Nil(t, err)
+	assert.Empty(t, claimedAgain) // leased
+	assert.Equal(t, "https://example.org", notifications[0].Url)
+	assert.JSONEq(t, `{"eventType": 0, "eventId": "1", "tick": 42, "transactionHash": "test-hash",
+		"sourceId": "SOURCE_IDENTITY", "destinationId": "TARGET_IDENTITY", "amount": "42"}`, string(notifications[0].Payload))
+
+	err = repository.RecordWebhookDelivery(context.Background(), WebhookDelivery{OutboxId: notifications[0].Id, Attempt: 1, StatusCode: 500, Error: "failed"},
+		WebhookStatusPending, time.Now().Add(time.Hour))
+	assert.Nil(t, err)
+	notifications, err = repository.ClaimDueWebhookNotifications(context.Background(), 100, 10, time.Minute)
+	assert.Nil(t, err)
+	assert.Empty(t, notifications) // not due
+
+	deliveries, err := repository.GetWebhookDeliveries(context.Background(), matching, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(deliveries))
+	assert.Equal(t, int64(1), deliveries[0].EventId)
+	assert.Equal(t, 500, deliveries[0].StatusCode)
+
+	// clean up
+	deleteWebhook(matching, t)
+	deleteWebhook(tooSmall, t)
+	deleteWebhook(assetsOnly, t)
+	_ = repository.UpdateLatestTick(context.Background(), originalTick)
+	deleteTransferQuEvent(transferId, t)
+	cleanupEventTestData(t, transactionId, tickId, eventId)
+	deleteEntity(sourceEntityId, t)
+	deleteEntity(destinationEntityId, t)
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
 	"github.com/ardanlabs/conf"
 	_ "github.com/golang-migrate/migrate/v4"
@@ -11,14 +13,18 @@ import (
 	"github.com/gookit/slog/rotatefile"
 	"github.com/pkg/errors"
 	"go-transfers/api"
+	"go-transfers/broadcast"
+	"go-transfers/certs"
 	"go-transfers/client"
 	"go-transfers/db"
 	"go-transfers/metrics"
 	"go-transfers/sync"
+	"go-transfers/webhook"
 	"log"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 )
 
 func main() {
@@ -31,11 +37,23 @@ type ServerConfig struct {
 	HttpHost    string `conf:"default:0.0.0.0:8000"`
 	GrpcHost    string `conf:"default:0.0.0.0:8001"`
 	MetricsHost string `conf:"default:0.0.0.0:8002"`
+	AdminHost   string // internal admin api (grpc). disabled, if empty.
 }
 
 type ClientConfig struct {
 	EventApiUrl string `conf:"required"`
 	CoreApiUrl  string `conf:"required"`
+	TlsEnabled  bool   `conf:"default:false"`
+	TlsCaFile   string // ca of the upstream servers. system roots, if empty.
+	TlsCertFile string // client certificate for mtls
+	TlsKeyFile  string
+}
+
+type TlsConfig struct {
+	CertFile       string // server certificate. plaintext, if empty.
+	KeyFile        string
+	ClientCaFile   string        // requires client certificates signed by this ca (mtls), if set
+	ReloadInterval time.Duration `conf:"default:1m"`
 }
 
 type DatabaseConfig struct {
@@ -53,6 +71,30 @@ type AppConfig struct {
 	ApiEnabled  bool `conf:"default:true"`
 }
 
+type WebhookConfig struct {
+	Enabled     bool          `conf:"default:false"` // dispatch notifications
+	Timeout     time.Duration `conf:"default:10s"`
+	MaxAttempts int           `conf:"default:10"`
+}
+
+type AuthConfig struct {
+	Enabled         bool          `conf:"default:false"` // check api keys and apply rate limits
+	Required        bool          `conf:"default:false"` // reject requests without api key
+	AnonymousRate   float64       `conf:"default:0"`     // requests per second without api key per client address. 0 means unlimited.
+	AnonymousBurst  int           `conf:"default:10"`
+	RefreshInterval time.Duration `conf:"default:30s"`
+}
+
+type CacheConfig struct {
+	Enabled      bool          `conf:"default:true"`
+	MaxEntries   int           `conf:"default:10000"` // cached tick responses
+	EntityMaxAge time.Duration `conf:"default:5s"`    // client side caching of tick and entity responses
+}
+
+type HealthConfig struct {
+	MaxSyncLag uint32 `conf:"default:100"` // ticks behind the event service, before the instance is not ready
+}
+
 type LogConfig struct {
 	Level     string `conf:"default:Info"`
 	FileError bool   `conf:"default:false"`
@@ -62,8 +104,13 @@ type LogConfig struct {
 type Config struct {
 	App      AppConfig
 	Server   ServerConfig
+	Tls      TlsConfig
 	Client   ClientConfig
 	Database DatabaseConfig
+	Webhook  WebhookConfig
+	Auth     AuthConfig
+	Cache    CacheConfig
+	Health   HealthConfig
 	Log      LogConfig
 }
 
@@ -120,12 +167,22 @@ func run() error {
 
 	// event processing
 	eventProcessor := sync.NewEventProcessor(repository)
-	eventClient, err := client.NewIntegrationEventClient(configuration.Client.EventApiUrl, configuration.Client.CoreApiUrl)
+	var clientTls *tls.Config
+	if cc := configuration.Client; cc.TlsEnabled {
+		reloader, err := certs.NewReloader(cc.TlsCertFile, cc.TlsKeyFile, cc.TlsCaFile)
+		if err != nil {
+			return errors.Wrap(err, "loading client certificates")
+		}
+		go reloader.ReloadInLoop(configuration.Tls.ReloadInterval)
+		clientTls = reloader.ClientConfig()
+	}
+	eventClient, err := client.NewIntegrationEventClient(configuration.Client.EventApiUrl, configuration.Client.CoreApiUrl, clientTls)
 	if err != nil {
 		return errors.Wrap(err, "creating event client")
 	}
 	meters := metrics.NewMetrics()
-	eventService, err := sync.NewEventService(eventClient, eventProcessor, repository, meters)
+	ticks := broadcast.NewTickBroadcaster()
+	eventService, err := sync.NewEventService(eventClient, eventProcessor, repository, meters, ticks)
 	if err != nil {
 		return errors.Wrap(err, "creating event service")
 	}
@@ -140,16 +197,53 @@ func run() error {
 	if configuration.App.ApiEnabled {
 		slog.Info("Starting api...")
 		// api
-		srv := api.NewServer(configuration.Server.GrpcHost, configuration.Server.HttpHost, repository)
+		var serverTls *tls.Config
+		if tc := configuration.Tls; tc.CertFile != "" {
+			reloader, err := certs.NewReloader(tc.CertFile, tc.KeyFile, tc.ClientCaFile)
+			if err != nil {
+				return errors.Wrap(err, "loading server certificates")
+			}
+			go reloader.ReloadInLoop(tc.ReloadInterval)
+			serverTls = reloader.ServerConfig()
+		}
+		var auth *api.KeyAuthenticator
+		if ac := configuration.Auth; ac.Enabled {
+			auth = api.NewKeyAuthenticator(repository, meters, ac.Required, ac.AnonymousRate, ac.AnonymousBurst)
+			err = auth.Refresh(context.Background())
+			if err != nil {
+				return errors.Wrap(err, "loading api keys")
+			}
+			go auth.RefreshInLoop(ac.RefreshInterval)
+		}
+		var cache *api.ResponseCache
+		if cc := configuration.Cache; cc.Enabled {
+			cache = api.NewResponseCache(cc.MaxEntries, cc.EntityMaxAge, meters)
+		}
+		health := api.NewHealthChecker(repository, eventClient, configuration.Health.MaxSyncLag)
+		srv := api.NewServer(configuration.Server.GrpcHost, configuration.Server.HttpHost, repository, ticks, auth, cache, meters, health, serverTls)
 		err = srv.Start()
 		if err != nil {
 			return errors.Wrap(err, "starting server")
 		}
 		slog.Info("Starting metrics api...")
-		metricsSrv := api.NewMetricsServer(configuration.Server.MetricsHost)
+		metricsSrv := api.NewMetricsServer(configuration.Server.MetricsHost, serverTls)
 		metricsSrv.Start()
 	}
 
+	if configuration.Server.AdminHost != "" {
+		slog.Info("Starting admin api...")
+		err = api.NewAdminServer(configuration.Server.AdminHost, repository).Start()
+		if err != nil {
+			return errors.Wrap(err, "starting admin server")
+		}
+	}
+
+	if configuration.Webhook.Enabled {
+		slog.Info("Starting webhook dispatcher...")
+		dispatcher := webhook.NewDispatcher(repository, configuration.Webhook.Timeout, configuration.Webhook.MaxAttempts)
+		go dispatcher.DispatchInLoop()
+	}
+
 	shutdown := make(chan os.Signal, 1)
 	signal.Notify(shutdown, os.Interrupt, syscall.SIGTERM)
 
@@ -1,6 +1,8 @@
 package metrics
 
 import (
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
@@ -10,6 +12,9 @@ type Metrics struct {
 	eventTickGauge     prometheus.Gauge
 	liveTickGauge      prometheus.Gauge
 	liveEpochGauge     prometheus.Gauge
+	apiRequestCounter  *prometheus.CounterVec
+	cacheCounter       *prometheus.CounterVec
+	requestHistogram   *prometheus.HistogramVec
 }
 
 func NewMetrics() *Metrics {
@@ -26,6 +31,19 @@ func NewMetrics() *Metrics {
 			Name: "qubic_transfers_live_tick",
 			Help: "The latest known live tick",
 		}),
+		apiRequestCounter: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "qubic_transfers_api_requests_total",
+			Help: "The number of api requests by api key and result",
+		}, []string{"key", "result"}),
+		cacheCounter: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "qubic_transfers_http_cache_requests_total",
+			Help: "The number of cacheable http requests by result (hit or miss)",
+		}, []string{"result"}),
+		requestHistogram: promauto.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "qubic_transfers_api_request_duration_seconds",
+			Help:    "The duration of grpc api requests by method and status code",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "code"}),
 	}
 	return &m
 }
@@ -41,3 +59,15 @@ func (metrics *Metrics) SetLatestEventTick(tick uint32) {
 func (metrics *Metrics) SetLatestLiveTick(tick uint32) {
 	metrics.liveTickGauge.Set(float64(tick))
 }
+
+func (metrics *Metrics) CountApiRequest(key, result string) {
+	metrics.apiRequestCounter.WithLabelValues(key, result).Inc()
+}
+
+func (metrics *Metrics) CountCacheRequest(result string) {
+	metrics.cacheCounter.WithLabelValues(result).Inc()
+}
+
+func (metrics *Metrics) ObserveApiRequest(method, code string, duration time.Duration) {
+	metrics.requestHistogram.WithLabelValues(method, code).Observe(duration.Seconds())
+}
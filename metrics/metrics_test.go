@@ -4,6 +4,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 var meters = NewMetrics()
@@ -22,3 +23,20 @@ func TestEventService_SetLatestAvailableLiveTick(t *testing.T) {
 	meters.SetLatestLiveTick(44)
 	assert.Equal(t, float64(44), testutil.ToFloat64(meters.liveTickGauge))
 }
+
+func TestEventService_CountApiRequest(t *testing.T) {
+	meters.CountApiRequest("test", "allowed")
+	meters.CountApiRequest("test", "allowed")
+	assert.Equal(t, float64(2), testutil.ToFloat64(meters.apiRequestCounter.WithLabelValues("test", "allowed")))
+}
+
+func TestEventService_CountCacheRequest(t *testing.T) {
+	meters.CountCacheRequest("hit")
+	assert.Equal(t, float64(1), testutil.ToFloat64(meters.cacheCounter.WithLabelValues("hit")))
+}
+
+func TestEventService_ObserveApiRequest(t *testing.T) {
+	meters.ObserveApiRequest("/qubic.transfers.api.TransferService/Health", "OK", 20*time.Millisecond)
+	meters.ObserveApiRequest("/qubic.transfers.api.TransferService/Health", "OK", 30*time.Millisecond)
+	assert.Equal(t, 1, testutil.CollectAndCount(meters.requestHistogram))
+}
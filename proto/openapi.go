@@ -0,0 +1,8 @@
+package proto
+
+import _ "embed"
+
+// OpenApiSpec is the generated swagger spec of the rest api. Regenerate with make openapi after changing the proto.
+//
+//go:embed qubic-transfers-rpc.swagger.json
+var OpenApiSpec []byte
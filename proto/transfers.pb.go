@@ -15,6 +15,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -24,6 +25,101 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Direction int32
+
+const (
+	Direction_BOTH     Direction = 0
+	Direction_INCOMING Direction = 1
+	Direction_OUTGOING Direction = 2
+)
+
+// Enum value maps for Direction.
+var (
+	Direction_name = map[int32]string{
+		0: "BOTH",
+		1: "INCOMING",
+		2: "OUTGOING",
+	}
+	Direction_value = map[string]int32{
+		"BOTH":     0,
+		"INCOMING": 1,
+		"OUTGOING": 2,
+	}
+)
+
+func (x Direction) Enum() *Direction {
+	p := new(Direction)
+	*p = x
+	return p
+}
+
+func (x Direction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Direction) Descriptor() protoreflect.EnumDescriptor {
+	return file_transfers_proto_enumTypes[0].Descriptor()
+}
+
+func (Direction) Type() protoreflect.EnumType {
+	return &file_transfers_proto_enumTypes[0]
+}
+
+func (x Direction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Direction.Descriptor instead.
+func (Direction) EnumDescriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{0}
+}
+
+type TraceDirection int32
+
+const (
+	TraceDirection_FORWARD  TraceDirection = 0 // follow outgoing transfers
+	TraceDirection_BACKWARD TraceDirection = 1 // follow incoming transfers
+)
+
+// Enum value maps for TraceDirection.
+var (
+	TraceDirection_name = map[int32]string{
+		0: "FORWARD",
+		1: "BACKWARD",
+	}
+	TraceDirection_value = map[string]int32{
+		"FORWARD":  0,
+		"BACKWARD": 1,
+	}
+)
+
+func (x TraceDirection) Enum() *TraceDirection {
+	p := new(TraceDirection)
+	*p = x
+	return p
+}
+
+func (x TraceDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TraceDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_transfers_proto_enumTypes[1].Descriptor()
+}
+
+func (TraceDirection) Type() protoreflect.EnumType {
+	return &file_transfers_proto_enumTypes[1]
+}
+
+func (x TraceDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TraceDirection.Descriptor instead.
+func (TraceDirection) EnumDescriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{1}
+}
+
 type HealthResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
@@ -175,6 +271,13 @@ func (x *TickRequest) GetTick() uint32 {
 type EntityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Identity      string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	FromTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`                         // inclusive, optional
+	ToTime        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`                               // exclusive, optional
+	PageSize      uint32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                        // optional. defaults to 100, max 1000.
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                      // optional. next_page_token of the previous page.
+	FromTick      uint32                 `protobuf:"varint,6,opt,name=from_tick,json=fromTick,proto3" json:"from_tick,omitempty"`                        // inclusive, optional
+	ToTick        uint32                 `protobuf:"varint,7,opt,name=to_tick,json=toTick,proto3" json:"to_tick,omitempty"`                              // inclusive, optional
+	Direction     Direction              `protobuf:"varint,8,opt,name=direction,proto3,enum=qubic.transfers.proto.Direction" json:"direction,omitempty"` // optional. defaults to both.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -216,28 +319,79 @@ func (x *EntityRequest) GetIdentity() string {
 	return ""
 }
 
-type AssetChangeEventsResponse struct {
+func (x *EntityRequest) GetFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromTime
+	}
+	return nil
+}
+
+func (x *EntityRequest) GetToTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToTime
+	}
+	return nil
+}
+
+func (x *EntityRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *EntityRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *EntityRequest) GetFromTick() uint32 {
+	if x != nil {
+		return x.FromTick
+	}
+	return 0
+}
+
+func (x *EntityRequest) GetToTick() uint32 {
+	if x != nil {
+		return x.ToTick
+	}
+	return 0
+}
+
+func (x *EntityRequest) GetDirection() Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return Direction_BOTH
+}
+
+type CounterpartiesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LatestTick    uint32                 `protobuf:"varint,1,opt,name=latestTick,proto3" json:"latestTick,omitempty"`
-	Events        []*AssetChangeEvent    `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	Identity      string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	FromTick      uint32                 `protobuf:"varint,2,opt,name=from_tick,json=fromTick,proto3" json:"from_tick,omitempty"` // inclusive, optional
+	ToTick        uint32                 `protobuf:"varint,3,opt,name=to_tick,json=toTick,proto3" json:"to_tick,omitempty"`       // inclusive, optional
+	Limit         uint32                 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                       // optional. max number of counterparties. defaults to 100, max 1000.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AssetChangeEventsResponse) Reset() {
-	*x = AssetChangeEventsResponse{}
+func (x *CounterpartiesRequest) Reset() {
+	*x = CounterpartiesRequest{}
 	mi := &file_transfers_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssetChangeEventsResponse) String() string {
+func (x *CounterpartiesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetChangeEventsResponse) ProtoMessage() {}
+func (*CounterpartiesRequest) ProtoMessage() {}
 
-func (x *AssetChangeEventsResponse) ProtoReflect() protoreflect.Message {
+func (x *CounterpartiesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_transfers_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -249,47 +403,66 @@ func (x *AssetChangeEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetChangeEventsResponse.ProtoReflect.Descriptor instead.
-func (*AssetChangeEventsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CounterpartiesRequest.ProtoReflect.Descriptor instead.
+func (*CounterpartiesRequest) Descriptor() ([]byte, []int) {
 	return file_transfers_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *AssetChangeEventsResponse) GetLatestTick() uint32 {
+func (x *CounterpartiesRequest) GetIdentity() string {
 	if x != nil {
-		return x.LatestTick
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *CounterpartiesRequest) GetFromTick() uint32 {
+	if x != nil {
+		return x.FromTick
 	}
 	return 0
 }
 
-func (x *AssetChangeEventsResponse) GetEvents() []*AssetChangeEvent {
+func (x *CounterpartiesRequest) GetToTick() uint32 {
 	if x != nil {
-		return x.Events
+		return x.ToTick
 	}
-	return nil
+	return 0
 }
 
-type AssetEventsResponse struct {
+func (x *CounterpartiesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TraceFundsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LatestTick    uint32                 `protobuf:"varint,1,opt,name=latestTick,proto3" json:"latestTick,omitempty"`
-	ChangeEvents  []*AssetChangeEvent    `protobuf:"bytes,2,rep,name=changeEvents,proto3" json:"changeEvents,omitempty"`
+	Identity      string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	Direction     TraceDirection         `protobuf:"varint,2,opt,name=direction,proto3,enum=qubic.transfers.proto.TraceDirection" json:"direction,omitempty"` // optional. defaults to forward.
+	FromTick      uint32                 `protobuf:"varint,3,opt,name=from_tick,json=fromTick,proto3" json:"from_tick,omitempty"`                             // inclusive, optional
+	ToTick        uint32                 `protobuf:"varint,4,opt,name=to_tick,json=toTick,proto3" json:"to_tick,omitempty"`                                   // inclusive, optional
+	MaxDepth      uint32                 `protobuf:"varint,5,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`                             // optional. number of hops. defaults to 3, max 5.
+	MaxFanOut     uint32                 `protobuf:"varint,6,opt,name=max_fan_out,json=maxFanOut,proto3" json:"max_fan_out,omitempty"`                        // optional. max edges per node, largest amounts first. defaults to 10, max 50.
+	MinAmount     uint64                 `protobuf:"varint,7,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`                          // optional. min total amount of an edge.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AssetEventsResponse) Reset() {
-	*x = AssetEventsResponse{}
+func (x *TraceFundsRequest) Reset() {
+	*x = TraceFundsRequest{}
 	mi := &file_transfers_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssetEventsResponse) String() string {
+func (x *TraceFundsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetEventsResponse) ProtoMessage() {}
+func (*TraceFundsRequest) ProtoMessage() {}
 
-func (x *AssetEventsResponse) ProtoReflect() protoreflect.Message {
+func (x *TraceFundsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_transfers_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -301,47 +474,81 @@ func (x *AssetEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetEventsResponse.ProtoReflect.Descriptor instead.
-func (*AssetEventsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TraceFundsRequest.ProtoReflect.Descriptor instead.
+func (*TraceFundsRequest) Descriptor() ([]byte, []int) {
 	return file_transfers_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *AssetEventsResponse) GetLatestTick() uint32 {
+func (x *TraceFundsRequest) GetIdentity() string {
 	if x != nil {
-		return x.LatestTick
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *TraceFundsRequest) GetDirection() TraceDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return TraceDirection_FORWARD
+}
+
+func (x *TraceFundsRequest) GetFromTick() uint32 {
+	if x != nil {
+		return x.FromTick
 	}
 	return 0
 }
 
-func (x *AssetEventsResponse) GetChangeEvents() []*AssetChangeEvent {
+func (x *TraceFundsRequest) GetToTick() uint32 {
 	if x != nil {
-		return x.ChangeEvents
+		return x.ToTick
 	}
-	return nil
+	return 0
 }
 
-type QuTransferEventsResponse struct {
+func (x *TraceFundsRequest) GetMaxDepth() uint32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *TraceFundsRequest) GetMaxFanOut() uint32 {
+	if x != nil {
+		return x.MaxFanOut
+	}
+	return 0
+}
+
+func (x *TraceFundsRequest) GetMinAmount() uint64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+type HoldingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LatestTick    uint32                 `protobuf:"varint,1,opt,name=latestTick,proto3" json:"latestTick,omitempty"`
-	Events        []*QuTransferEvent     `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	Identity      string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QuTransferEventsResponse) Reset() {
-	*x = QuTransferEventsResponse{}
+func (x *HoldingsRequest) Reset() {
+	*x = HoldingsRequest{}
 	mi := &file_transfers_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QuTransferEventsResponse) String() string {
+func (x *HoldingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuTransferEventsResponse) ProtoMessage() {}
+func (*HoldingsRequest) ProtoMessage() {}
 
-func (x *QuTransferEventsResponse) ProtoReflect() protoreflect.Message {
+func (x *HoldingsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_transfers_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -353,51 +560,40 @@ func (x *QuTransferEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuTransferEventsResponse.ProtoReflect.Descriptor instead.
-func (*QuTransferEventsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HoldingsRequest.ProtoReflect.Descriptor instead.
+func (*HoldingsRequest) Descriptor() ([]byte, []int) {
 	return file_transfers_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *QuTransferEventsResponse) GetLatestTick() uint32 {
-	if x != nil {
-		return x.LatestTick
-	}
-	return 0
-}
-
-func (x *QuTransferEventsResponse) GetEvents() []*QuTransferEvent {
+func (x *HoldingsRequest) GetIdentity() string {
 	if x != nil {
-		return x.Events
+		return x.Identity
 	}
-	return nil
+	return ""
 }
 
-type QuTransferEvent struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	SourceId        string                 `protobuf:"bytes,1,opt,name=sourceId,proto3" json:"sourceId,omitempty"`
-	DestinationId   string                 `protobuf:"bytes,2,opt,name=destinationId,proto3" json:"destinationId,omitempty"`
-	Amount          uint64                 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	TransactionHash string                 `protobuf:"bytes,4,opt,name=transactionHash,proto3" json:"transactionHash,omitempty"`
-	Tick            uint32                 `protobuf:"varint,5,opt,name=tick,proto3" json:"tick,omitempty"`
-	EventType       uint32                 `protobuf:"varint,6,opt,name=eventType,proto3" json:"eventType,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type AssetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issuer        string                 `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QuTransferEvent) Reset() {
-	*x = QuTransferEvent{}
+func (x *AssetRequest) Reset() {
+	*x = AssetRequest{}
 	mi := &file_transfers_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QuTransferEvent) String() string {
+func (x *AssetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuTransferEvent) ProtoMessage() {}
+func (*AssetRequest) ProtoMessage() {}
 
-func (x *QuTransferEvent) ProtoReflect() protoreflect.Message {
+func (x *AssetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_transfers_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -409,81 +605,51 @@ func (x *QuTransferEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuTransferEvent.ProtoReflect.Descriptor instead.
-func (*QuTransferEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use AssetRequest.ProtoReflect.Descriptor instead.
+func (*AssetRequest) Descriptor() ([]byte, []int) {
 	return file_transfers_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *QuTransferEvent) GetSourceId() string {
-	if x != nil {
-		return x.SourceId
-	}
-	return ""
-}
-
-func (x *QuTransferEvent) GetDestinationId() string {
+func (x *AssetRequest) GetIssuer() string {
 	if x != nil {
-		return x.DestinationId
+		return x.Issuer
 	}
 	return ""
 }
 
-func (x *QuTransferEvent) GetAmount() uint64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *QuTransferEvent) GetTransactionHash() string {
+func (x *AssetRequest) GetName() string {
 	if x != nil {
-		return x.TransactionHash
+		return x.Name
 	}
 	return ""
 }
 
-func (x *QuTransferEvent) GetTick() uint32 {
-	if x != nil {
-		return x.Tick
-	}
-	return 0
-}
-
-func (x *QuTransferEvent) GetEventType() uint32 {
-	if x != nil {
-		return x.EventType
-	}
-	return 0
-}
-
-type AssetChangeEvent struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	SourceId        string                 `protobuf:"bytes,1,opt,name=sourceId,proto3" json:"sourceId,omitempty"`
-	DestinationId   string                 `protobuf:"bytes,2,opt,name=destinationId,proto3" json:"destinationId,omitempty"`
-	IssuerId        string                 `protobuf:"bytes,3,opt,name=issuerId,proto3" json:"issuerId,omitempty"`
-	Name            string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	NumberOfShares  uint64                 `protobuf:"varint,5,opt,name=numberOfShares,proto3" json:"numberOfShares,omitempty"`
-	TransactionHash string                 `protobuf:"bytes,6,opt,name=transactionHash,proto3" json:"transactionHash,omitempty"`
-	Tick            uint32                 `protobuf:"varint,7,opt,name=tick,proto3" json:"tick,omitempty"`
-	EventType       uint32                 `protobuf:"varint,8,opt,name=eventType,proto3" json:"eventType,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type AssetEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issuer        string                 `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // optional. defaults to 100, max 1000.
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // optional. next_page_token of the previous page.
+	FromTick      uint32                 `protobuf:"varint,5,opt,name=from_tick,json=fromTick,proto3" json:"from_tick,omitempty"`   // inclusive, optional
+	ToTick        uint32                 `protobuf:"varint,6,opt,name=to_tick,json=toTick,proto3" json:"to_tick,omitempty"`         // inclusive, optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AssetChangeEvent) Reset() {
-	*x = AssetChangeEvent{}
+func (x *AssetEventsRequest) Reset() {
+	*x = AssetEventsRequest{}
 	mi := &file_transfers_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssetChangeEvent) String() string {
+func (x *AssetEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssetChangeEvent) ProtoMessage() {}
+func (*AssetEventsRequest) ProtoMessage() {}
 
-func (x *AssetChangeEvent) ProtoReflect() protoreflect.Message {
+func (x *AssetEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_transfers_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -495,72 +661,2692 @@ func (x *AssetChangeEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssetChangeEvent.ProtoReflect.Descriptor instead.
-func (*AssetChangeEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use AssetEventsRequest.ProtoReflect.Descriptor instead.
+func (*AssetEventsRequest) Descriptor() ([]byte, []int) {
 	return file_transfers_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *AssetChangeEvent) GetSourceId() string {
+func (x *AssetEventsRequest) GetIssuer() string {
 	if x != nil {
-		return x.SourceId
+		return x.Issuer
 	}
 	return ""
 }
 
-func (x *AssetChangeEvent) GetDestinationId() string {
+func (x *AssetEventsRequest) GetName() string {
 	if x != nil {
-		return x.DestinationId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *AssetChangeEvent) GetIssuerId() string {
+func (x *AssetEventsRequest) GetPageSize() uint32 {
 	if x != nil {
-		return x.IssuerId
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *AssetChangeEvent) GetName() string {
+func (x *AssetEventsRequest) GetPageToken() string {
 	if x != nil {
-		return x.Name
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *AssetChangeEvent) GetNumberOfShares() uint64 {
+func (x *AssetEventsRequest) GetFromTick() uint32 {
 	if x != nil {
-		return x.NumberOfShares
+		return x.FromTick
 	}
 	return 0
 }
 
-func (x *AssetChangeEvent) GetTransactionHash() string {
+func (x *AssetEventsRequest) GetToTick() uint32 {
 	if x != nil {
-		return x.TransactionHash
+		return x.ToTick
 	}
-	return ""
+	return 0
+}
+
+type AssetKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issuer        string                 `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssetKey) Reset() {
+	*x = AssetKey{}
+	mi := &file_transfers_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetKey) ProtoMessage() {}
+
+func (x *AssetKey) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetKey.ProtoReflect.Descriptor instead.
+func (*AssetKey) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AssetKey) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *AssetKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// events match, if any of the identities is source or destination or if the asset matches.
+// without identities and assets all events match.
+type SubscribeTransfersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identities    []string               `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
+	Assets        []*AssetKey            `protobuf:"bytes,2,rep,name=assets,proto3" json:"assets,omitempty"`
+	StartTick     uint32                 `protobuf:"varint,3,opt,name=start_tick,json=startTick,proto3" json:"start_tick,omitempty"` // optional. inclusive. defaults to the tick after the latest processed tick. at most 1000 ticks behind the latest tick.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeTransfersRequest) Reset() {
+	*x = SubscribeTransfersRequest{}
+	mi := &file_transfers_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeTransfersRequest) ProtoMessage() {}
+
+func (x *SubscribeTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeTransfersRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SubscribeTransfersRequest) GetIdentities() []string {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+func (x *SubscribeTransfersRequest) GetAssets() []*AssetKey {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *SubscribeTransfersRequest) GetStartTick() uint32 {
+	if x != nil {
+		return x.StartTick
+	}
+	return 0
+}
+
+type EntitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identities    []string               `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`              // max 100
+	FromTick      uint32                 `protobuf:"varint,2,opt,name=from_tick,json=fromTick,proto3" json:"from_tick,omitempty"` // inclusive
+	ToTick        uint32                 `protobuf:"varint,3,opt,name=to_tick,json=toTick,proto3" json:"to_tick,omitempty"`       // inclusive. max 10000 ticks after from_tick.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntitiesRequest) Reset() {
+	*x = EntitiesRequest{}
+	mi := &file_transfers_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntitiesRequest) ProtoMessage() {}
+
+func (x *EntitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntitiesRequest.ProtoReflect.Descriptor instead.
+func (*EntitiesRequest) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EntitiesRequest) GetIdentities() []string {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+func (x *EntitiesRequest) GetFromTick() uint32 {
+	if x != nil {
+		return x.FromTick
+	}
+	return 0
+}
+
+func (x *EntitiesRequest) GetToTick() uint32 {
+	if x != nil {
+		return x.ToTick
+	}
+	return 0
+}
+
+type TickRangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromTick      uint32                 `protobuf:"varint,1,opt,name=from_tick,json=fromTick,proto3" json:"from_tick,omitempty"`   // inclusive
+	ToTick        uint32                 `protobuf:"varint,2,opt,name=to_tick,json=toTick,proto3" json:"to_tick,omitempty"`         // inclusive. max 1000 ticks after from_tick.
+	PageSize      uint32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // optional. defaults to 100, max 1000.
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // optional. next_page_token of the previous page.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TickRangeRequest) Reset() {
+	*x = TickRangeRequest{}
+	mi := &file_transfers_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TickRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TickRangeRequest) ProtoMessage() {}
+
+func (x *TickRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TickRangeRequest.ProtoReflect.Descriptor instead.
+func (*TickRangeRequest) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TickRangeRequest) GetFromTick() uint32 {
+	if x != nil {
+		return x.FromTick
+	}
+	return 0
+}
+
+func (x *TickRangeRequest) GetToTick() uint32 {
+	if x != nil {
+		return x.ToTick
+	}
+	return 0
+}
+
+func (x *TickRangeRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *TickRangeRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type TransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionRequest) Reset() {
+	*x = TransactionRequest{}
+	mi := &file_transfers_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionRequest) ProtoMessage() {}
+
+func (x *TransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionRequest.ProtoReflect.Descriptor instead.
+func (*TransactionRequest) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TransactionRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type AssetChangeEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LatestTick    uint32                 `protobuf:"varint,1,opt,name=latestTick,proto3" json:"latestTick,omitempty"`
+	Events        []*AssetChangeEvent    `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // only for paged requests. empty, if there are no more events.
+	TotalCount    uint64                 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // only for paged requests. total number of events matching the request.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssetChangeEventsResponse) Reset() {
+	*x = AssetChangeEventsResponse{}
+	mi := &file_transfers_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetChangeEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetChangeEventsResponse) ProtoMessage() {}
+
+func (x *AssetChangeEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetChangeEventsResponse.ProtoReflect.Descriptor instead.
+func (*AssetChangeEventsResponse) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AssetChangeEventsResponse) GetLatestTick() uint32 {
+	if x != nil {
+		return x.LatestTick
+	}
+	return 0
+}
+
+func (x *AssetChangeEventsResponse) GetEvents() []*AssetChangeEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *AssetChangeEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *AssetChangeEventsResponse) GetTotalCount() uint64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type AssetEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LatestTick    uint32                 `protobuf:"varint,1,opt,name=latestTick,proto3" json:"latestTick,omitempty"`
+	ChangeEvents  []*AssetChangeEvent    `protobuf:"bytes,2,rep,name=changeEvents,proto3" json:"changeEvents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssetEventsResponse) Reset() {
+	*x = AssetEventsResponse{}
+	mi := &file_transfers_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetEventsResponse) ProtoMessage() {}
+
+func (x *AssetEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetEventsResponse.ProtoReflect.Descriptor instead.
+func (*AssetEventsResponse) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AssetEventsResponse) GetLatestTick() uint32 {
+	if x != nil {
+		return x.LatestTick
+	}
+	return 0
+}
+
+func (x *AssetEventsResponse) GetChangeEvents() []*AssetChangeEvent {
+	if x != nil {
+		return x.ChangeEvents
+	}
+	return nil
+}
+
+type QuTransferEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LatestTick    uint32                 `protobuf:"varint,1,opt,name=latestTick,proto3" json:"latestTick,omitempty"`
+	Events        []*QuTransferEvent     `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // only for paged requests. empty, if there are no more events.
+	TotalCount    uint64                 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // only for paged requests. total number of events matching the request.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuTransferEventsResponse) Reset() {
+	*x = QuTransferEventsResponse{}
+	mi := &file_transfers_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuTransferEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuTransferEventsResponse) ProtoMessage() {}
+
+func (x *QuTransferEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuTransferEventsResponse.ProtoReflect.Descriptor instead.
+func (*QuTransferEventsResponse) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QuTransferEventsResponse) GetLatestTick() uint32 {
+	if x != nil {
+		return x.LatestTick
+	}
+	return 0
+}
+
+func (x *QuTransferEventsResponse) GetEvents() []*QuTransferEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *QuTransferEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *QuTransferEventsResponse) GetTotalCount() uint64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// events of the transaction in event order
+type TransactionEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LatestTick    uint32                 `protobuf:"varint,1,opt,name=latestTick,proto3" json:"latestTick,omitempty"`
+	QuTransfers   []*QuTransferEvent     `protobuf:"bytes,2,rep,name=quTransfers,proto3" json:"quTransfers,omitempty"`
+	AssetChanges  []*AssetChangeEvent    `protobuf:"bytes,3,rep,name=assetChanges,proto3" json:"assetChanges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionEventsResponse) Reset() {
+	*x = TransactionEventsResponse{}
+	mi := &file_transfers_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionEventsResponse) ProtoMessage() {}
+
+func (x *TransactionEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionEventsResponse.ProtoReflect.Descriptor instead.
+func (*TransactionEventsResponse) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TransactionEventsResponse) GetLatestTick() uint32 {
+	if x != nil {
+		return x.LatestTick
+	}
+	return 0
+}
+
+func (x *TransactionEventsResponse) GetQuTransfers() []*QuTransferEvent {
+	if x != nil {
+		return x.QuTransfers
+	}
+	return nil
+}
+
+func (x *TransactionEventsResponse) GetAssetChanges() []*AssetChangeEvent {
+	if x != nil {
+		return x.AssetChanges
+	}
+	return nil
+}
+
+// events of the tick range in event order. a page contains up to page_size events of both types.
+type TickRangeEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LatestTick    uint32                 `protobuf:"varint,1,opt,name=latestTick,proto3" json:"latestTick,omitempty"`
+	QuTransfers   []*QuTransferEvent     `protobuf:"bytes,2,rep,name=quTransfers,proto3" json:"quTransfers,omitempty"`
+	AssetChanges  []*AssetChangeEvent    `protobuf:"bytes,3,rep,name=assetChanges,proto3" json:"assetChanges,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty, if there are no more events.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TickRangeEventsResponse) Reset() {
+	*x = TickRangeEventsResponse{}
+	mi := &file_transfers_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TickRangeEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TickRangeEventsResponse) ProtoMessage() {}
+
+func (x *TickRangeEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TickRangeEventsResponse.ProtoReflect.Descriptor instead.
+func (*TickRangeEventsResponse) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TickRangeEventsResponse) GetLatestTick() uint32 {
+	if x != nil {
+		return x.LatestTick
+	}
+	return 0
+}
+
+func (x *TickRangeEventsResponse) GetQuTransfers() []*QuTransferEvent {
+	if x != nil {
+		return x.QuTransfers
+	}
+	return nil
+}
+
+func (x *TickRangeEventsResponse) GetAssetChanges() []*AssetChangeEvent {
+	if x != nil {
+		return x.AssetChanges
+	}
+	return nil
+}
+
+func (x *TickRangeEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type EntitiesTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LatestTick    uint32                 `protobuf:"varint,1,opt,name=latestTick,proto3" json:"latestTick,omitempty"`
+	Entities      []*EntityTransfers     `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"` // in request order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntitiesTransfersResponse) Reset() {
+	*x = EntitiesTransfersResponse{}
+	mi := &file_transfers_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntitiesTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntitiesTransfersResponse) ProtoMessage() {}
+
+func (x *EntitiesTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntitiesTransfersResponse.ProtoReflect.Descriptor instead.
+func (*EntitiesTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EntitiesTransfersResponse) GetLatestTick() uint32 {
+	if x != nil {
+		return x.LatestTick
+	}
+	return 0
+}
+
+func (x *EntitiesTransfersResponse) GetEntities() []*EntityTransfers {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+// events of one entity, latest first
+type EntityTransfers struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identity      string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	QuTransfers   []*QuTransferEvent     `protobuf:"bytes,2,rep,name=quTransfers,proto3" json:"quTransfers,omitempty"`
+	AssetChanges  []*AssetChangeEvent    `protobuf:"bytes,3,rep,name=assetChanges,proto3" json:"assetChanges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntityTransfers) Reset() {
+	*x = EntityTransfers{}
+	mi := &file_transfers_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityTransfers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityTransfers) ProtoMessage() {}
+
+func (x *EntityTransfers) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityTransfers.ProtoReflect.Descriptor instead.
+func (*EntityTransfers) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *EntityTransfers) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *EntityTransfers) GetQuTransfers() []*QuTransferEvent {
+	if x != nil {
+		return x.QuTransfers
+	}
+	return nil
+}
+
+func (x *EntityTransfers) GetAssetChanges() []*AssetChangeEvent {
+	if x != nil {
+		return x.AssetChanges
+	}
+	return nil
+}
+
+// matching events of one processed tick in event order
+type TickTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tick          uint32                 `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	QuTransfers   []*QuTransferEvent     `protobuf:"bytes,2,rep,name=quTransfers,proto3" json:"quTransfers,omitempty"`
+	AssetChanges  []*AssetChangeEvent    `protobuf:"bytes,3,rep,name=assetChanges,proto3" json:"assetChanges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TickTransfersResponse) Reset() {
+	*x = TickTransfersResponse{}
+	mi := &file_transfers_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TickTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TickTransfersResponse) ProtoMessage() {}
+
+func (x *TickTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TickTransfersResponse.ProtoReflect.Descriptor instead.
+func (*TickTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TickTransfersResponse) GetTick() uint32 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *TickTransfersResponse) GetQuTransfers() []*QuTransferEvent {
+	if x != nil {
+		return x.QuTransfers
+	}
+	return nil
+}
+
+func (x *TickTransfersResponse) GetAssetChanges() []*AssetChangeEvent {
+	if x != nil {
+		return x.AssetChanges
+	}
+	return nil
+}
+
+type QuBalanceHistoryResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LatestTick uint32                 `protobuf:"varint,1,opt,name=latestTick,proto3" json:"latestTick,omitempty"`
+	// transfers from and to AAA (mining, burning, ...) are not tracked. Balances do not reconcile with the on-chain balance.
+	AaaTransfersExcluded bool               `protobuf:"varint,2,opt,name=aaaTransfersExcluded,proto3" json:"aaaTransfersExcluded,omitempty"`
+	Changes              []*QuBalanceChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	NextPageToken        string             `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty, if there are no more changes.
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *QuBalanceHistoryResponse) Reset() {
+	*x = QuBalanceHistoryResponse{}
+	mi := &file_transfers_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuBalanceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuBalanceHistoryResponse) ProtoMessage() {}
+
+func (x *QuBalanceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuBalanceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*QuBalanceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *QuBalanceHistoryResponse) GetLatestTick() uint32 {
+	if x != nil {
+		return x.LatestTick
+	}
+	return 0
+}
+
+func (x *QuBalanceHistoryResponse) GetAaaTransfersExcluded() bool {
+	if x != nil {
+		return x.AaaTransfersExcluded
+	}
+	return false
+}
+
+func (x *QuBalanceHistoryResponse) GetChanges() []*QuBalanceChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *QuBalanceHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type QuBalanceChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tick          uint32                 `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	Change        string                 `protobuf:"bytes,2,opt,name=change,proto3" json:"change,omitempty"`        // decimal string. sum of all tracked transfers in the tick. negative for outgoing amounts.
+	Balance       string                 `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`      // decimal string. running total of all tracked transfers after the tick.
+	Timestamp     uint64                 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // tick time in unix milliseconds. 0, if unknown.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuBalanceChange) Reset() {
+	*x = QuBalanceChange{}
+	mi := &file_transfers_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuBalanceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuBalanceChange) ProtoMessage() {}
+
+func (x *QuBalanceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuBalanceChange.ProtoReflect.Descriptor instead.
+func (*QuBalanceChange) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *QuBalanceChange) GetTick() uint32 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *QuBalanceChange) GetChange() string {
+	if x != nil {
+		return x.Change
+	}
+	return ""
+}
+
+func (x *QuBalanceChange) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *QuBalanceChange) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// counterparties with the most transfers first. asset volumes count ownership changes only.
+type CounterpartiesResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LatestTick     uint32                 `protobuf:"varint,1,opt,name=latestTick,proto3" json:"latestTick,omitempty"`
+	Counterparties []*Counterparty        `protobuf:"bytes,2,rep,name=counterparties,proto3" json:"counterparties,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CounterpartiesResponse) Reset() {
+	*x = CounterpartiesResponse{}
+	mi := &file_transfers_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CounterpartiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CounterpartiesResponse) ProtoMessage() {}
+
+func (x *CounterpartiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CounterpartiesResponse.ProtoReflect.Descriptor instead.
+func (*CounterpartiesResponse) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CounterpartiesResponse) GetLatestTick() uint32 {
+	if x != nil {
+		return x.LatestTick
+	}
+	return 0
+}
+
+func (x *CounterpartiesResponse) GetCounterparties() []*Counterparty {
+	if x != nil {
+		return x.Counterparties
+	}
+	return nil
+}
+
+type Counterparty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identity      string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	TransferCount uint64                 `protobuf:"varint,2,opt,name=transferCount,proto3" json:"transferCount,omitempty"`
+	FirstTick     uint32                 `protobuf:"varint,3,opt,name=firstTick,proto3" json:"firstTick,omitempty"`
+	LastTick      uint32                 `protobuf:"varint,4,opt,name=lastTick,proto3" json:"lastTick,omitempty"`
+	Volumes       []*CounterpartyVolume  `protobuf:"bytes,5,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Counterparty) Reset() {
+	*x = Counterparty{}
+	mi := &file_transfers_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Counterparty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Counterparty) ProtoMessage() {}
+
+func (x *Counterparty) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Counterparty.ProtoReflect.Descriptor instead.
+func (*Counterparty) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Counterparty) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *Counterparty) GetTransferCount() uint64 {
+	if x != nil {
+		return x.TransferCount
+	}
+	return 0
+}
+
+func (x *Counterparty) GetFirstTick() uint32 {
+	if x != nil {
+		return x.FirstTick
+	}
+	return 0
+}
+
+func (x *Counterparty) GetLastTick() uint32 {
+	if x != nil {
+		return x.LastTick
+	}
+	return 0
+}
+
+func (x *Counterparty) GetVolumes() []*CounterpartyVolume {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+// transfer volume of one asset. issuer and name are empty for qu.
+type CounterpartyVolume struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IssuerId       string                 `protobuf:"bytes,1,opt,name=issuerId,proto3" json:"issuerId,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TransferCount  uint64                 `protobuf:"varint,3,opt,name=transferCount,proto3" json:"transferCount,omitempty"`
+	IncomingAmount string                 `protobuf:"bytes,4,opt,name=incomingAmount,proto3" json:"incomingAmount,omitempty"` // decimal string. sums may exceed the uint64 range.
+	OutgoingAmount string                 `protobuf:"bytes,5,opt,name=outgoingAmount,proto3" json:"outgoingAmount,omitempty"` // decimal string. sums may exceed the uint64 range.
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CounterpartyVolume) Reset() {
+	*x = CounterpartyVolume{}
+	mi := &file_transfers_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CounterpartyVolume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CounterpartyVolume) ProtoMessage() {}
+
+func (x *CounterpartyVolume) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CounterpartyVolume.ProtoReflect.Descriptor instead.
+func (*CounterpartyVolume) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CounterpartyVolume) GetIssuerId() string {
+	if x != nil {
+		return x.IssuerId
+	}
+	return ""
+}
+
+func (x *CounterpartyVolume) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CounterpartyVolume) GetTransferCount() uint64 {
+	if x != nil {
+		return x.TransferCount
+	}
+	return 0
+}
+
+func (x *CounterpartyVolume) GetIncomingAmount() string {
+	if x != nil {
+		return x.IncomingAmount
+	}
+	return ""
+}
+
+func (x *CounterpartyVolume) GetOutgoingAmount() string {
+	if x != nil {
+		return x.OutgoingAmount
+	}
+	return ""
+}
+
+// graph of the qu flows starting at the requested identity (depth 0).
+type TraceFundsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LatestTick    uint32                 `protobuf:"varint,1,opt,name=latestTick,proto3" json:"latestTick,omitempty"`
+	Nodes         []*TraceNode           `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges         []*TraceEdge           `protobuf:"bytes,3,rep,name=edges,proto3" json:"edges,omitempty"`
+	Truncated     bool                   `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"` // true, if the max number of nodes is reached.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TraceFundsResponse) Reset() {
+	*x = TraceFundsResponse{}
+	mi := &file_transfers_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TraceFundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceFundsResponse) ProtoMessage() {}
+
+func (x *TraceFundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceFundsResponse.ProtoReflect.Descriptor instead.
+func (*TraceFundsResponse) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TraceFundsResponse) GetLatestTick() uint32 {
+	if x != nil {
+		return x.LatestTick
+	}
+	return 0
+}
+
+func (x *TraceFundsResponse) GetNodes() []*TraceNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *TraceFundsResponse) GetEdges() []*TraceEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *TraceFundsResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type TraceNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identity      string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	Depth         uint32                 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"` // number of hops from the requested identity
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TraceNode) Reset() {
+	*x = TraceNode{}
+	mi := &file_transfers_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TraceNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceNode) ProtoMessage() {}
+
+func (x *TraceNode) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceNode.ProtoReflect.Descriptor instead.
+func (*TraceNode) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TraceNode) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *TraceNode) GetDepth() uint32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+// sum of all transfers from source to destination within the tick range
+type TraceEdge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      string                 `protobuf:"bytes,1,opt,name=sourceId,proto3" json:"sourceId,omitempty"`
+	DestinationId string                 `protobuf:"bytes,2,opt,name=destinationId,proto3" json:"destinationId,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"` // decimal string. sums may exceed the uint64 range.
+	TransferCount uint64                 `protobuf:"varint,4,opt,name=transferCount,proto3" json:"transferCount,omitempty"`
+	FirstTick     uint32                 `protobuf:"varint,5,opt,name=firstTick,proto3" json:"firstTick,omitempty"`
+	LastTick      uint32                 `protobuf:"varint,6,opt,name=lastTick,proto3" json:"lastTick,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TraceEdge) Reset() {
+	*x = TraceEdge{}
+	mi := &file_transfers_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TraceEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceEdge) ProtoMessage() {}
+
+func (x *TraceEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceEdge.ProtoReflect.Descriptor instead.
+func (*TraceEdge) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TraceEdge) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *TraceEdge) GetDestinationId() string {
+	if x != nil {
+		return x.DestinationId
+	}
+	return ""
+}
+
+func (x *TraceEdge) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TraceEdge) GetTransferCount() uint64 {
+	if x != nil {
+		return x.TransferCount
+	}
+	return 0
+}
+
+func (x *TraceEdge) GetFirstTick() uint32 {
+	if x != nil {
+		return x.FirstTick
+	}
+	return 0
+}
+
+func (x *TraceEdge) GetLastTick() uint32 {
+	if x != nil {
+		return x.LastTick
+	}
+	return 0
+}
+
+// holdings are derived from the tracked asset changes. only positive holdings are returned.
+type AssetHoldingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LatestTick    uint32                 `protobuf:"varint,1,opt,name=latestTick,proto3" json:"latestTick,omitempty"`
+	Holdings      []*AssetHolding        `protobuf:"bytes,2,rep,name=holdings,proto3" json:"holdings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssetHoldingsResponse) Reset() {
+	*x = AssetHoldingsResponse{}
+	mi := &file_transfers_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetHoldingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetHoldingsResponse) ProtoMessage() {}
+
+func (x *AssetHoldingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetHoldingsResponse.ProtoReflect.Descriptor instead.
+func (*AssetHoldingsResponse) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AssetHoldingsResponse) GetLatestTick() uint32 {
+	if x != nil {
+		return x.LatestTick
+	}
+	return 0
+}
+
+func (x *AssetHoldingsResponse) GetHoldings() []*AssetHolding {
+	if x != nil {
+		return x.Holdings
+	}
+	return nil
+}
+
+type AssetHolding struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	IssuerId                 string                 `protobuf:"bytes,1,opt,name=issuerId,proto3" json:"issuerId,omitempty"`
+	Name                     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OwnedShares              uint64                 `protobuf:"varint,3,opt,name=ownedShares,proto3" json:"ownedShares,omitempty"`
+	PossessedShares          uint64                 `protobuf:"varint,4,opt,name=possessedShares,proto3" json:"possessedShares,omitempty"`
+	NumberOfDecimals         uint32                 `protobuf:"varint,5,opt,name=numberOfDecimals,proto3" json:"numberOfDecimals,omitempty"`                // 0, if unknown
+	FormattedOwnedShares     string                 `protobuf:"bytes,6,opt,name=formattedOwnedShares,proto3" json:"formattedOwnedShares,omitempty"`         // owned shares with decimals
+	FormattedPossessedShares string                 `protobuf:"bytes,7,opt,name=formattedPossessedShares,proto3" json:"formattedPossessedShares,omitempty"` // possessed shares with decimals
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *AssetHolding) Reset() {
+	*x = AssetHolding{}
+	mi := &file_transfers_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetHolding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetHolding) ProtoMessage() {}
+
+func (x *AssetHolding) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetHolding.ProtoReflect.Descriptor instead.
+func (*AssetHolding) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AssetHolding) GetIssuerId() string {
+	if x != nil {
+		return x.IssuerId
+	}
+	return ""
+}
+
+func (x *AssetHolding) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssetHolding) GetOwnedShares() uint64 {
+	if x != nil {
+		return x.OwnedShares
+	}
+	return 0
+}
+
+func (x *AssetHolding) GetPossessedShares() uint64 {
+	if x != nil {
+		return x.PossessedShares
+	}
+	return 0
+}
+
+func (x *AssetHolding) GetNumberOfDecimals() uint32 {
+	if x != nil {
+		return x.NumberOfDecimals
+	}
+	return 0
+}
+
+func (x *AssetHolding) GetFormattedOwnedShares() string {
+	if x != nil {
+		return x.FormattedOwnedShares
+	}
+	return ""
+}
+
+func (x *AssetHolding) GetFormattedPossessedShares() string {
+	if x != nil {
+		return x.FormattedPossessedShares
+	}
+	return ""
+}
+
+// holders are derived from the tracked asset changes. only positive holdings are returned.
+type AssetHoldersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LatestTick    uint32                 `protobuf:"varint,1,opt,name=latestTick,proto3" json:"latestTick,omitempty"`
+	Holders       []*AssetHolder         `protobuf:"bytes,2,rep,name=holders,proto3" json:"holders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssetHoldersResponse) Reset() {
+	*x = AssetHoldersResponse{}
+	mi := &file_transfers_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetHoldersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetHoldersResponse) ProtoMessage() {}
+
+func (x *AssetHoldersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetHoldersResponse.ProtoReflect.Descriptor instead.
+func (*AssetHoldersResponse) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AssetHoldersResponse) GetLatestTick() uint32 {
+	if x != nil {
+		return x.LatestTick
+	}
+	return 0
+}
+
+func (x *AssetHoldersResponse) GetHolders() []*AssetHolder {
+	if x != nil {
+		return x.Holders
+	}
+	return nil
+}
+
+type AssetHolder struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Identity                 string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	OwnedShares              uint64                 `protobuf:"varint,2,opt,name=ownedShares,proto3" json:"ownedShares,omitempty"`
+	PossessedShares          uint64                 `protobuf:"varint,3,opt,name=possessedShares,proto3" json:"possessedShares,omitempty"`
+	FormattedOwnedShares     string                 `protobuf:"bytes,4,opt,name=formattedOwnedShares,proto3" json:"formattedOwnedShares,omitempty"`         // owned shares with decimals of the asset
+	FormattedPossessedShares string                 `protobuf:"bytes,5,opt,name=formattedPossessedShares,proto3" json:"formattedPossessedShares,omitempty"` // possessed shares with decimals of the asset
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *AssetHolder) Reset() {
+	*x = AssetHolder{}
+	mi := &file_transfers_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetHolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetHolder) ProtoMessage() {}
+
+func (x *AssetHolder) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetHolder.ProtoReflect.Descriptor instead.
+func (*AssetHolder) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AssetHolder) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *AssetHolder) GetOwnedShares() uint64 {
+	if x != nil {
+		return x.OwnedShares
+	}
+	return 0
+}
+
+func (x *AssetHolder) GetPossessedShares() uint64 {
+	if x != nil {
+		return x.PossessedShares
+	}
+	return 0
+}
+
+func (x *AssetHolder) GetFormattedOwnedShares() string {
+	if x != nil {
+		return x.FormattedOwnedShares
+	}
+	return ""
+}
+
+func (x *AssetHolder) GetFormattedPossessedShares() string {
+	if x != nil {
+		return x.FormattedPossessedShares
+	}
+	return ""
+}
+
+type AssetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LatestTick    uint32                 `protobuf:"varint,1,opt,name=latestTick,proto3" json:"latestTick,omitempty"`
+	Assets        []*Asset               `protobuf:"bytes,2,rep,name=assets,proto3" json:"assets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssetsResponse) Reset() {
+	*x = AssetsResponse{}
+	mi := &file_transfers_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetsResponse) ProtoMessage() {}
+
+func (x *AssetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetsResponse.ProtoReflect.Descriptor instead.
+func (*AssetsResponse) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AssetsResponse) GetLatestTick() uint32 {
+	if x != nil {
+		return x.LatestTick
+	}
+	return 0
+}
+
+func (x *AssetsResponse) GetAssets() []*Asset {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+type Asset struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	IssuerId          string                 `protobuf:"bytes,1,opt,name=issuerId,proto3" json:"issuerId,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Verified          bool                   `protobuf:"varint,3,opt,name=verified,proto3" json:"verified,omitempty"`
+	TransferCount     uint64                 `protobuf:"varint,4,opt,name=transferCount,proto3" json:"transferCount,omitempty"`        // number of tracked ownership changes
+	NumberOfDecimals  uint32                 `protobuf:"varint,5,opt,name=numberOfDecimals,proto3" json:"numberOfDecimals,omitempty"`  // 0, if unknown
+	UnitOfMeasurement string                 `protobuf:"bytes,6,opt,name=unitOfMeasurement,proto3" json:"unitOfMeasurement,omitempty"` // base64 encoded. empty, if unknown.
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Asset) Reset() {
+	*x = Asset{}
+	mi := &file_transfers_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Asset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Asset) ProtoMessage() {}
+
+func (x *Asset) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Asset.ProtoReflect.Descriptor instead.
+func (*Asset) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Asset) GetIssuerId() string {
+	if x != nil {
+		return x.IssuerId
+	}
+	return ""
+}
+
+func (x *Asset) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Asset) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *Asset) GetTransferCount() uint64 {
+	if x != nil {
+		return x.TransferCount
+	}
+	return 0
+}
+
+func (x *Asset) GetNumberOfDecimals() uint32 {
+	if x != nil {
+		return x.NumberOfDecimals
+	}
+	return 0
+}
+
+func (x *Asset) GetUnitOfMeasurement() string {
+	if x != nil {
+		return x.UnitOfMeasurement
+	}
+	return ""
+}
+
+type QuTransferEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SourceId        string                 `protobuf:"bytes,1,opt,name=sourceId,proto3" json:"sourceId,omitempty"`
+	DestinationId   string                 `protobuf:"bytes,2,opt,name=destinationId,proto3" json:"destinationId,omitempty"`
+	Amount          uint64                 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	TransactionHash string                 `protobuf:"bytes,4,opt,name=transactionHash,proto3" json:"transactionHash,omitempty"`
+	Tick            uint32                 `protobuf:"varint,5,opt,name=tick,proto3" json:"tick,omitempty"`
+	EventType       uint32                 `protobuf:"varint,6,opt,name=eventType,proto3" json:"eventType,omitempty"`
+	Timestamp       uint64                 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // tick time in unix milliseconds. 0, if unknown.
+	EventId         uint64                 `protobuf:"varint,8,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QuTransferEvent) Reset() {
+	*x = QuTransferEvent{}
+	mi := &file_transfers_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuTransferEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuTransferEvent) ProtoMessage() {}
+
+func (x *QuTransferEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuTransferEvent.ProtoReflect.Descriptor instead.
+func (*QuTransferEvent) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *QuTransferEvent) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *QuTransferEvent) GetDestinationId() string {
+	if x != nil {
+		return x.DestinationId
+	}
+	return ""
+}
+
+func (x *QuTransferEvent) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *QuTransferEvent) GetTransactionHash() string {
+	if x != nil {
+		return x.TransactionHash
+	}
+	return ""
+}
+
+func (x *QuTransferEvent) GetTick() uint32 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *QuTransferEvent) GetEventType() uint32 {
+	if x != nil {
+		return x.EventType
+	}
+	return 0
+}
+
+func (x *QuTransferEvent) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *QuTransferEvent) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+type AssetChangeEvent struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	SourceId                string                 `protobuf:"bytes,1,opt,name=sourceId,proto3" json:"sourceId,omitempty"`
+	DestinationId           string                 `protobuf:"bytes,2,opt,name=destinationId,proto3" json:"destinationId,omitempty"`
+	IssuerId                string                 `protobuf:"bytes,3,opt,name=issuerId,proto3" json:"issuerId,omitempty"`
+	Name                    string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	NumberOfShares          uint64                 `protobuf:"varint,5,opt,name=numberOfShares,proto3" json:"numberOfShares,omitempty"`
+	TransactionHash         string                 `protobuf:"bytes,6,opt,name=transactionHash,proto3" json:"transactionHash,omitempty"`
+	Tick                    uint32                 `protobuf:"varint,7,opt,name=tick,proto3" json:"tick,omitempty"`
+	EventType               uint32                 `protobuf:"varint,8,opt,name=eventType,proto3" json:"eventType,omitempty"`
+	Timestamp               uint64                 `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // tick time in unix milliseconds. 0, if unknown.
+	EventId                 uint64                 `protobuf:"varint,10,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	NumberOfDecimals        uint32                 `protobuf:"varint,11,opt,name=numberOfDecimals,proto3" json:"numberOfDecimals,omitempty"`              // 0, if unknown
+	FormattedNumberOfShares string                 `protobuf:"bytes,12,opt,name=formattedNumberOfShares,proto3" json:"formattedNumberOfShares,omitempty"` // number of shares with decimals
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *AssetChangeEvent) Reset() {
+	*x = AssetChangeEvent{}
+	mi := &file_transfers_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetChangeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetChangeEvent) ProtoMessage() {}
+
+func (x *AssetChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetChangeEvent.ProtoReflect.Descriptor instead.
+func (*AssetChangeEvent) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AssetChangeEvent) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *AssetChangeEvent) GetDestinationId() string {
+	if x != nil {
+		return x.DestinationId
+	}
+	return ""
+}
+
+func (x *AssetChangeEvent) GetIssuerId() string {
+	if x != nil {
+		return x.IssuerId
+	}
+	return ""
+}
+
+func (x *AssetChangeEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssetChangeEvent) GetNumberOfShares() uint64 {
+	if x != nil {
+		return x.NumberOfShares
+	}
+	return 0
+}
+
+func (x *AssetChangeEvent) GetTransactionHash() string {
+	if x != nil {
+		return x.TransactionHash
+	}
+	return ""
+}
+
+func (x *AssetChangeEvent) GetTick() uint32 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *AssetChangeEvent) GetEventType() uint32 {
+	if x != nil {
+		return x.EventType
+	}
+	return 0
+}
+
+func (x *AssetChangeEvent) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *AssetChangeEvent) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *AssetChangeEvent) GetNumberOfDecimals() uint32 {
+	if x != nil {
+		return x.NumberOfDecimals
+	}
+	return 0
+}
+
+func (x *AssetChangeEvent) GetFormattedNumberOfShares() string {
+	if x != nil {
+		return x.FormattedNumberOfShares
+	}
+	return ""
+}
+
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`                                   // optional. generated, if empty. min 16 characters.
+	Identities    []string               `protobuf:"bytes,3,rep,name=identities,proto3" json:"identities,omitempty"`                           // optional. source or destination.
+	Assets        []*AssetKey            `protobuf:"bytes,4,rep,name=assets,proto3" json:"assets,omitempty"`                                   // optional. only asset changes of the assets match.
+	EventTypes    []uint32               `protobuf:"varint,5,rep,packed,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"` // optional. 0, 2 or 3.
+	MinAmount     uint64                 `protobuf:"varint,6,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`           // optional. amount or number of shares.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_transfers_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetIdentities() []string {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+func (x *CreateWebhookRequest) GetAssets() []*AssetKey {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *CreateWebhookRequest) GetEventTypes() []uint32 {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *CreateWebhookRequest) GetMinAmount() uint64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+type WebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookRequest) Reset() {
+	*x = WebhookRequest{}
+	mi := &file_transfers_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookRequest) ProtoMessage() {}
+
+func (x *WebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookRequest.ProtoReflect.Descriptor instead.
+func (*WebhookRequest) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *WebhookRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Webhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Identities    []string               `protobuf:"bytes,3,rep,name=identities,proto3" json:"identities,omitempty"`
+	Assets        []*AssetKey            `protobuf:"bytes,4,rep,name=assets,proto3" json:"assets,omitempty"`
+	EventTypes    []uint32               `protobuf:"varint,5,rep,packed,name=eventTypes,proto3" json:"eventTypes,omitempty"`
+	MinAmount     uint64                 `protobuf:"varint,6,opt,name=minAmount,proto3" json:"minAmount,omitempty"`
+	Enabled       bool                   `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Secret        string                 `protobuf:"bytes,8,opt,name=secret,proto3" json:"secret,omitempty"` // only returned on creation
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_transfers_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Webhook) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetIdentities() []string {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+func (x *Webhook) GetAssets() []*AssetKey {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *Webhook) GetEventTypes() []uint32 {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Webhook) GetMinAmount() uint64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *Webhook) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type WebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhooksResponse) Reset() {
+	*x = WebhooksResponse{}
+	mi := &file_transfers_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhooksResponse) ProtoMessage() {}
+
+func (x *WebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhooksResponse.ProtoReflect.Descriptor instead.
+func (*WebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *WebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type WebhookDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NotificationId uint64                 `protobuf:"varint,1,opt,name=notificationId,proto3" json:"notificationId,omitempty"`
+	EventId        uint64                 `protobuf:"varint,2,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	Attempt        uint32                 `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	StatusCode     uint32                 `protobuf:"varint,4,opt,name=statusCode,proto3" json:"statusCode,omitempty"` // 0, if there was no response
+	Error          string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	DurationMs     uint64                 `protobuf:"varint,6,opt,name=durationMs,proto3" json:"durationMs,omitempty"`
+	Timestamp      uint64                 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // unix milliseconds
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_transfers_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *WebhookDelivery) GetNotificationId() uint64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
 }
 
-func (x *AssetChangeEvent) GetTick() uint32 {
+func (x *WebhookDelivery) GetAttempt() uint32 {
 	if x != nil {
-		return x.Tick
+		return x.Attempt
 	}
 	return 0
 }
 
-func (x *AssetChangeEvent) GetEventType() uint32 {
+func (x *WebhookDelivery) GetStatusCode() uint32 {
 	if x != nil {
-		return x.EventType
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetDurationMs() uint64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type WebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDeliveriesResponse) Reset() {
+	*x = WebhookDeliveriesResponse{}
+	mi := &file_transfers_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *WebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *WebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+// internal api. must not be exposed publicly.
+type CreateApiKeyRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RequestsPerSecond float64                `protobuf:"fixed64,2,opt,name=requests_per_second,json=requestsPerSecond,proto3" json:"requests_per_second,omitempty"` // optional. 0 means unlimited.
+	Burst             uint32                 `protobuf:"varint,3,opt,name=burst,proto3" json:"burst,omitempty"`                                                     // optional. max requests at once. at least 1.
+	DailyQuota        uint64                 `protobuf:"varint,4,opt,name=daily_quota,json=dailyQuota,proto3" json:"daily_quota,omitempty"`                         // optional. max requests per utc day. 0 means unlimited.
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyRequest) Reset() {
+	*x = CreateApiKeyRequest{}
+	mi := &file_transfers_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyRequest) ProtoMessage() {}
+
+func (x *CreateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateApiKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetRequestsPerSecond() float64 {
+	if x != nil {
+		return x.RequestsPerSecond
+	}
+	return 0
+}
+
+func (x *CreateApiKeyRequest) GetBurst() uint32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+func (x *CreateApiKeyRequest) GetDailyQuota() uint64 {
+	if x != nil {
+		return x.DailyQuota
+	}
+	return 0
+}
+
+type ApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKeyRequest) Reset() {
+	*x = ApiKeyRequest{}
+	mi := &file_transfers_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeyRequest) ProtoMessage() {}
+
+func (x *ApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*ApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ApiKeyRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ApiKey struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Key               string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"` // only returned on creation. send as x-api-key header or metadata.
+	KeyPrefix         string                 `protobuf:"bytes,4,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+	RequestsPerSecond float64                `protobuf:"fixed64,5,opt,name=requests_per_second,json=requestsPerSecond,proto3" json:"requests_per_second,omitempty"`
+	Burst             uint32                 `protobuf:"varint,6,opt,name=burst,proto3" json:"burst,omitempty"`
+	DailyQuota        uint64                 `protobuf:"varint,7,opt,name=daily_quota,json=dailyQuota,proto3" json:"daily_quota,omitempty"`
+	Enabled           bool                   `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	mi := &file_transfers_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ApiKey) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApiKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ApiKey) GetKeyPrefix() string {
+	if x != nil {
+		return x.KeyPrefix
+	}
+	return ""
+}
+
+func (x *ApiKey) GetRequestsPerSecond() float64 {
+	if x != nil {
+		return x.RequestsPerSecond
+	}
+	return 0
+}
+
+func (x *ApiKey) GetBurst() uint32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+func (x *ApiKey) GetDailyQuota() uint64 {
+	if x != nil {
+		return x.DailyQuota
 	}
 	return 0
 }
 
+func (x *ApiKey) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type ApiKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*ApiKey              `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKeysResponse) Reset() {
+	*x = ApiKeysResponse{}
+	mi := &file_transfers_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeysResponse) ProtoMessage() {}
+
+func (x *ApiKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transfers_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeysResponse.ProtoReflect.Descriptor instead.
+func (*ApiKeysResponse) Descriptor() ([]byte, []int) {
+	return file_transfers_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ApiKeysResponse) GetKeys() []*ApiKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
 var File_transfers_proto protoreflect.FileDescriptor
 
 const file_transfers_proto_rawDesc = "" +
 	"\n" +
-	"\x0ftransfers.proto\x12\x15qubic.transfers.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xe0\x01\n" +
+	"\x0ftransfers.proto\x12\x15qubic.transfers.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe0\x01\n" +
 	"\x0eHealthResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12U\n" +
 	"\n" +
@@ -576,31 +3362,206 @@ const file_transfers_proto_rawDesc = "" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"!\n" +
 	"\vTickRequest\x12\x12\n" +
-	"\x04tick\x18\x01 \x01(\rR\x04tick\"+\n" +
+	"\x04tick\x18\x01 \x01(\rR\x04tick\"\xcb\x02\n" +
 	"\rEntityRequest\x12\x1a\n" +
-	"\bidentity\x18\x01 \x01(\tR\bidentity\"|\n" +
+	"\bidentity\x18\x01 \x01(\tR\bidentity\x127\n" +
+	"\tfrom_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bfromTime\x123\n" +
+	"\ato_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06toTime\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\tfrom_tick\x18\x06 \x01(\rR\bfromTick\x12\x17\n" +
+	"\ato_tick\x18\a \x01(\rR\x06toTick\x12>\n" +
+	"\tdirection\x18\b \x01(\x0e2 .qubic.transfers.proto.DirectionR\tdirection\"\x7f\n" +
+	"\x15CounterpartiesRequest\x12\x1a\n" +
+	"\bidentity\x18\x01 \x01(\tR\bidentity\x12\x1b\n" +
+	"\tfrom_tick\x18\x02 \x01(\rR\bfromTick\x12\x17\n" +
+	"\ato_tick\x18\x03 \x01(\rR\x06toTick\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\rR\x05limit\"\x86\x02\n" +
+	"\x11TraceFundsRequest\x12\x1a\n" +
+	"\bidentity\x18\x01 \x01(\tR\bidentity\x12C\n" +
+	"\tdirection\x18\x02 \x01(\x0e2%.qubic.transfers.proto.TraceDirectionR\tdirection\x12\x1b\n" +
+	"\tfrom_tick\x18\x03 \x01(\rR\bfromTick\x12\x17\n" +
+	"\ato_tick\x18\x04 \x01(\rR\x06toTick\x12\x1b\n" +
+	"\tmax_depth\x18\x05 \x01(\rR\bmaxDepth\x12\x1e\n" +
+	"\vmax_fan_out\x18\x06 \x01(\rR\tmaxFanOut\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\a \x01(\x04R\tminAmount\"-\n" +
+	"\x0fHoldingsRequest\x12\x1a\n" +
+	"\bidentity\x18\x01 \x01(\tR\bidentity\":\n" +
+	"\fAssetRequest\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xb2\x01\n" +
+	"\x12AssetEventsRequest\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\tfrom_tick\x18\x05 \x01(\rR\bfromTick\x12\x17\n" +
+	"\ato_tick\x18\x06 \x01(\rR\x06toTick\"6\n" +
+	"\bAssetKey\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x93\x01\n" +
+	"\x19SubscribeTransfersRequest\x12\x1e\n" +
+	"\n" +
+	"identities\x18\x01 \x03(\tR\n" +
+	"identities\x127\n" +
+	"\x06assets\x18\x02 \x03(\v2\x1f.qubic.transfers.proto.AssetKeyR\x06assets\x12\x1d\n" +
+	"\n" +
+	"start_tick\x18\x03 \x01(\rR\tstartTick\"g\n" +
+	"\x0fEntitiesRequest\x12\x1e\n" +
+	"\n" +
+	"identities\x18\x01 \x03(\tR\n" +
+	"identities\x12\x1b\n" +
+	"\tfrom_tick\x18\x02 \x01(\rR\bfromTick\x12\x17\n" +
+	"\ato_tick\x18\x03 \x01(\rR\x06toTick\"\x84\x01\n" +
+	"\x10TickRangeRequest\x12\x1b\n" +
+	"\tfrom_tick\x18\x01 \x01(\rR\bfromTick\x12\x17\n" +
+	"\ato_tick\x18\x02 \x01(\rR\x06toTick\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"(\n" +
+	"\x12TransactionRequest\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\"\xc5\x01\n" +
 	"\x19AssetChangeEventsResponse\x12\x1e\n" +
 	"\n" +
 	"latestTick\x18\x01 \x01(\rR\n" +
 	"latestTick\x12?\n" +
-	"\x06events\x18\x02 \x03(\v2'.qubic.transfers.proto.AssetChangeEventR\x06events\"\x82\x01\n" +
+	"\x06events\x18\x02 \x03(\v2'.qubic.transfers.proto.AssetChangeEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\x04R\n" +
+	"totalCount\"\x82\x01\n" +
 	"\x13AssetEventsResponse\x12\x1e\n" +
 	"\n" +
 	"latestTick\x18\x01 \x01(\rR\n" +
 	"latestTick\x12K\n" +
-	"\fchangeEvents\x18\x02 \x03(\v2'.qubic.transfers.proto.AssetChangeEventR\fchangeEvents\"z\n" +
+	"\fchangeEvents\x18\x02 \x03(\v2'.qubic.transfers.proto.AssetChangeEventR\fchangeEvents\"\xc3\x01\n" +
 	"\x18QuTransferEventsResponse\x12\x1e\n" +
 	"\n" +
 	"latestTick\x18\x01 \x01(\rR\n" +
 	"latestTick\x12>\n" +
-	"\x06events\x18\x02 \x03(\v2&.qubic.transfers.proto.QuTransferEventR\x06events\"\xc7\x01\n" +
+	"\x06events\x18\x02 \x03(\v2&.qubic.transfers.proto.QuTransferEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\x04R\n" +
+	"totalCount\"\xd2\x01\n" +
+	"\x19TransactionEventsResponse\x12\x1e\n" +
+	"\n" +
+	"latestTick\x18\x01 \x01(\rR\n" +
+	"latestTick\x12H\n" +
+	"\vquTransfers\x18\x02 \x03(\v2&.qubic.transfers.proto.QuTransferEventR\vquTransfers\x12K\n" +
+	"\fassetChanges\x18\x03 \x03(\v2'.qubic.transfers.proto.AssetChangeEventR\fassetChanges\"\xf8\x01\n" +
+	"\x17TickRangeEventsResponse\x12\x1e\n" +
+	"\n" +
+	"latestTick\x18\x01 \x01(\rR\n" +
+	"latestTick\x12H\n" +
+	"\vquTransfers\x18\x02 \x03(\v2&.qubic.transfers.proto.QuTransferEventR\vquTransfers\x12K\n" +
+	"\fassetChanges\x18\x03 \x03(\v2'.qubic.transfers.proto.AssetChangeEventR\fassetChanges\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"\x7f\n" +
+	"\x19EntitiesTransfersResponse\x12\x1e\n" +
+	"\n" +
+	"latestTick\x18\x01 \x01(\rR\n" +
+	"latestTick\x12B\n" +
+	"\bentities\x18\x02 \x03(\v2&.qubic.transfers.proto.EntityTransfersR\bentities\"\xc4\x01\n" +
+	"\x0fEntityTransfers\x12\x1a\n" +
+	"\bidentity\x18\x01 \x01(\tR\bidentity\x12H\n" +
+	"\vquTransfers\x18\x02 \x03(\v2&.qubic.transfers.proto.QuTransferEventR\vquTransfers\x12K\n" +
+	"\fassetChanges\x18\x03 \x03(\v2'.qubic.transfers.proto.AssetChangeEventR\fassetChanges\"\xc2\x01\n" +
+	"\x15TickTransfersResponse\x12\x12\n" +
+	"\x04tick\x18\x01 \x01(\rR\x04tick\x12H\n" +
+	"\vquTransfers\x18\x02 \x03(\v2&.qubic.transfers.proto.QuTransferEventR\vquTransfers\x12K\n" +
+	"\fassetChanges\x18\x03 \x03(\v2'.qubic.transfers.proto.AssetChangeEventR\fassetChanges\"\xd8\x01\n" +
+	"\x18QuBalanceHistoryResponse\x12\x1e\n" +
+	"\n" +
+	"latestTick\x18\x01 \x01(\rR\n" +
+	"latestTick\x122\n" +
+	"\x14aaaTransfersExcluded\x18\x02 \x01(\bR\x14aaaTransfersExcluded\x12@\n" +
+	"\achanges\x18\x03 \x03(\v2&.qubic.transfers.proto.QuBalanceChangeR\achanges\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"u\n" +
+	"\x0fQuBalanceChange\x12\x12\n" +
+	"\x04tick\x18\x01 \x01(\rR\x04tick\x12\x16\n" +
+	"\x06change\x18\x02 \x01(\tR\x06change\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\tR\abalance\x12\x1c\n" +
+	"\ttimestamp\x18\x04 \x01(\x04R\ttimestamp\"\x85\x01\n" +
+	"\x16CounterpartiesResponse\x12\x1e\n" +
+	"\n" +
+	"latestTick\x18\x01 \x01(\rR\n" +
+	"latestTick\x12K\n" +
+	"\x0ecounterparties\x18\x02 \x03(\v2#.qubic.transfers.proto.CounterpartyR\x0ecounterparties\"\xcf\x01\n" +
+	"\fCounterparty\x12\x1a\n" +
+	"\bidentity\x18\x01 \x01(\tR\bidentity\x12$\n" +
+	"\rtransferCount\x18\x02 \x01(\x04R\rtransferCount\x12\x1c\n" +
+	"\tfirstTick\x18\x03 \x01(\rR\tfirstTick\x12\x1a\n" +
+	"\blastTick\x18\x04 \x01(\rR\blastTick\x12C\n" +
+	"\avolumes\x18\x05 \x03(\v2).qubic.transfers.proto.CounterpartyVolumeR\avolumes\"\xba\x01\n" +
+	"\x12CounterpartyVolume\x12\x1a\n" +
+	"\bissuerId\x18\x01 \x01(\tR\bissuerId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12$\n" +
+	"\rtransferCount\x18\x03 \x01(\x04R\rtransferCount\x12&\n" +
+	"\x0eincomingAmount\x18\x04 \x01(\tR\x0eincomingAmount\x12&\n" +
+	"\x0eoutgoingAmount\x18\x05 \x01(\tR\x0eoutgoingAmount\"\xc2\x01\n" +
+	"\x12TraceFundsResponse\x12\x1e\n" +
+	"\n" +
+	"latestTick\x18\x01 \x01(\rR\n" +
+	"latestTick\x126\n" +
+	"\x05nodes\x18\x02 \x03(\v2 .qubic.transfers.proto.TraceNodeR\x05nodes\x126\n" +
+	"\x05edges\x18\x03 \x03(\v2 .qubic.transfers.proto.TraceEdgeR\x05edges\x12\x1c\n" +
+	"\ttruncated\x18\x04 \x01(\bR\ttruncated\"=\n" +
+	"\tTraceNode\x12\x1a\n" +
+	"\bidentity\x18\x01 \x01(\tR\bidentity\x12\x14\n" +
+	"\x05depth\x18\x02 \x01(\rR\x05depth\"\xc5\x01\n" +
+	"\tTraceEdge\x12\x1a\n" +
+	"\bsourceId\x18\x01 \x01(\tR\bsourceId\x12$\n" +
+	"\rdestinationId\x18\x02 \x01(\tR\rdestinationId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12$\n" +
+	"\rtransferCount\x18\x04 \x01(\x04R\rtransferCount\x12\x1c\n" +
+	"\tfirstTick\x18\x05 \x01(\rR\tfirstTick\x12\x1a\n" +
+	"\blastTick\x18\x06 \x01(\rR\blastTick\"x\n" +
+	"\x15AssetHoldingsResponse\x12\x1e\n" +
+	"\n" +
+	"latestTick\x18\x01 \x01(\rR\n" +
+	"latestTick\x12?\n" +
+	"\bholdings\x18\x02 \x03(\v2#.qubic.transfers.proto.AssetHoldingR\bholdings\"\xa6\x02\n" +
+	"\fAssetHolding\x12\x1a\n" +
+	"\bissuerId\x18\x01 \x01(\tR\bissuerId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vownedShares\x18\x03 \x01(\x04R\vownedShares\x12(\n" +
+	"\x0fpossessedShares\x18\x04 \x01(\x04R\x0fpossessedShares\x12*\n" +
+	"\x10numberOfDecimals\x18\x05 \x01(\rR\x10numberOfDecimals\x122\n" +
+	"\x14formattedOwnedShares\x18\x06 \x01(\tR\x14formattedOwnedShares\x12:\n" +
+	"\x18formattedPossessedShares\x18\a \x01(\tR\x18formattedPossessedShares\"t\n" +
+	"\x14AssetHoldersResponse\x12\x1e\n" +
+	"\n" +
+	"latestTick\x18\x01 \x01(\rR\n" +
+	"latestTick\x12<\n" +
+	"\aholders\x18\x02 \x03(\v2\".qubic.transfers.proto.AssetHolderR\aholders\"\xe5\x01\n" +
+	"\vAssetHolder\x12\x1a\n" +
+	"\bidentity\x18\x01 \x01(\tR\bidentity\x12 \n" +
+	"\vownedShares\x18\x02 \x01(\x04R\vownedShares\x12(\n" +
+	"\x0fpossessedShares\x18\x03 \x01(\x04R\x0fpossessedShares\x122\n" +
+	"\x14formattedOwnedShares\x18\x04 \x01(\tR\x14formattedOwnedShares\x12:\n" +
+	"\x18formattedPossessedShares\x18\x05 \x01(\tR\x18formattedPossessedShares\"f\n" +
+	"\x0eAssetsResponse\x12\x1e\n" +
+	"\n" +
+	"latestTick\x18\x01 \x01(\rR\n" +
+	"latestTick\x124\n" +
+	"\x06assets\x18\x02 \x03(\v2\x1c.qubic.transfers.proto.AssetR\x06assets\"\xd3\x01\n" +
+	"\x05Asset\x12\x1a\n" +
+	"\bissuerId\x18\x01 \x01(\tR\bissuerId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bverified\x18\x03 \x01(\bR\bverified\x12$\n" +
+	"\rtransferCount\x18\x04 \x01(\x04R\rtransferCount\x12*\n" +
+	"\x10numberOfDecimals\x18\x05 \x01(\rR\x10numberOfDecimals\x12,\n" +
+	"\x11unitOfMeasurement\x18\x06 \x01(\tR\x11unitOfMeasurement\"\xff\x01\n" +
 	"\x0fQuTransferEvent\x12\x1a\n" +
 	"\bsourceId\x18\x01 \x01(\tR\bsourceId\x12$\n" +
 	"\rdestinationId\x18\x02 \x01(\tR\rdestinationId\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x04R\x06amount\x12(\n" +
 	"\x0ftransactionHash\x18\x04 \x01(\tR\x0ftransactionHash\x12\x12\n" +
 	"\x04tick\x18\x05 \x01(\rR\x04tick\x12\x1c\n" +
-	"\teventType\x18\x06 \x01(\rR\teventType\"\x88\x02\n" +
+	"\teventType\x18\x06 \x01(\rR\teventType\x12\x1c\n" +
+	"\ttimestamp\x18\a \x01(\x04R\ttimestamp\x12\x18\n" +
+	"\aeventId\x18\b \x01(\x04R\aeventId\"\xa6\x03\n" +
 	"\x10AssetChangeEvent\x12\x1a\n" +
 	"\bsourceId\x18\x01 \x01(\tR\bsourceId\x12$\n" +
 	"\rdestinationId\x18\x02 \x01(\tR\rdestinationId\x12\x1a\n" +
@@ -609,14 +3570,112 @@ const file_transfers_proto_rawDesc = "" +
 	"\x0enumberOfShares\x18\x05 \x01(\x04R\x0enumberOfShares\x12(\n" +
 	"\x0ftransactionHash\x18\x06 \x01(\tR\x0ftransactionHash\x12\x12\n" +
 	"\x04tick\x18\a \x01(\rR\x04tick\x12\x1c\n" +
-	"\teventType\x18\b \x01(\rR\teventType2\xc0\a\n" +
+	"\teventType\x18\b \x01(\rR\teventType\x12\x1c\n" +
+	"\ttimestamp\x18\t \x01(\x04R\ttimestamp\x12\x18\n" +
+	"\aeventId\x18\n" +
+	" \x01(\x04R\aeventId\x12*\n" +
+	"\x10numberOfDecimals\x18\v \x01(\rR\x10numberOfDecimals\x128\n" +
+	"\x17formattedNumberOfShares\x18\f \x01(\tR\x17formattedNumberOfShares\"\xd9\x01\n" +
+	"\x14CreateWebhookRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x1e\n" +
+	"\n" +
+	"identities\x18\x03 \x03(\tR\n" +
+	"identities\x127\n" +
+	"\x06assets\x18\x04 \x03(\v2\x1f.qubic.transfers.proto.AssetKeyR\x06assets\x12\x1f\n" +
+	"\vevent_types\x18\x05 \x03(\rR\n" +
+	"eventTypes\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\x06 \x01(\x04R\tminAmount\" \n" +
+	"\x0eWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\xf4\x01\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1e\n" +
+	"\n" +
+	"identities\x18\x03 \x03(\tR\n" +
+	"identities\x127\n" +
+	"\x06assets\x18\x04 \x03(\v2\x1f.qubic.transfers.proto.AssetKeyR\x06assets\x12\x1e\n" +
+	"\n" +
+	"eventTypes\x18\x05 \x03(\rR\n" +
+	"eventTypes\x12\x1c\n" +
+	"\tminAmount\x18\x06 \x01(\x04R\tminAmount\x12\x18\n" +
+	"\aenabled\x18\a \x01(\bR\aenabled\x12\x16\n" +
+	"\x06secret\x18\b \x01(\tR\x06secret\"N\n" +
+	"\x10WebhooksResponse\x12:\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x1e.qubic.transfers.proto.WebhookR\bwebhooks\"\xe1\x01\n" +
+	"\x0fWebhookDelivery\x12&\n" +
+	"\x0enotificationId\x18\x01 \x01(\x04R\x0enotificationId\x12\x18\n" +
+	"\aeventId\x18\x02 \x01(\x04R\aeventId\x12\x18\n" +
+	"\aattempt\x18\x03 \x01(\rR\aattempt\x12\x1e\n" +
+	"\n" +
+	"statusCode\x18\x04 \x01(\rR\n" +
+	"statusCode\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\x1e\n" +
+	"\n" +
+	"durationMs\x18\x06 \x01(\x04R\n" +
+	"durationMs\x12\x1c\n" +
+	"\ttimestamp\x18\a \x01(\x04R\ttimestamp\"c\n" +
+	"\x19WebhookDeliveriesResponse\x12F\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2&.qubic.transfers.proto.WebhookDeliveryR\n" +
+	"deliveries\"\x90\x01\n" +
+	"\x13CreateApiKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12.\n" +
+	"\x13requests_per_second\x18\x02 \x01(\x01R\x11requestsPerSecond\x12\x14\n" +
+	"\x05burst\x18\x03 \x01(\rR\x05burst\x12\x1f\n" +
+	"\vdaily_quota\x18\x04 \x01(\x04R\n" +
+	"dailyQuota\"\x1f\n" +
+	"\rApiKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\xde\x01\n" +
+	"\x06ApiKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"key_prefix\x18\x04 \x01(\tR\tkeyPrefix\x12.\n" +
+	"\x13requests_per_second\x18\x05 \x01(\x01R\x11requestsPerSecond\x12\x14\n" +
+	"\x05burst\x18\x06 \x01(\rR\x05burst\x12\x1f\n" +
+	"\vdaily_quota\x18\a \x01(\x04R\n" +
+	"dailyQuota\x12\x18\n" +
+	"\aenabled\x18\b \x01(\bR\aenabled\"D\n" +
+	"\x0fApiKeysResponse\x121\n" +
+	"\x04keys\x18\x01 \x03(\v2\x1d.qubic.transfers.proto.ApiKeyR\x04keys*1\n" +
+	"\tDirection\x12\b\n" +
+	"\x04BOTH\x10\x00\x12\f\n" +
+	"\bINCOMING\x10\x01\x12\f\n" +
+	"\bOUTGOING\x10\x02*+\n" +
+	"\x0eTraceDirection\x12\v\n" +
+	"\aFORWARD\x10\x00\x12\f\n" +
+	"\bBACKWARD\x10\x012\xcb\x14\n" +
 	"\x0fTransferService\x12_\n" +
 	"\x06Health\x12\x16.google.protobuf.Empty\x1a%.qubic.transfers.proto.HealthResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/status/health\x12\x93\x01\n" +
 	"\x15GetAssetEventsForTick\x12\".qubic.transfers.proto.TickRequest\x1a*.qubic.transfers.proto.AssetEventsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/ticks/{tick}/events/assets\x12\xa8\x01\n" +
 	"\x1bGetAssetChangeEventsForTick\x12\".qubic.transfers.proto.TickRequest\x1a0.qubic.transfers.proto.AssetChangeEventsResponse\"3\x82\xd3\xe4\x93\x02-\x12+/api/v1/ticks/{tick}/events/asset-transfers\x12\xb3\x01\n" +
 	"\x1dGetAssetChangeEventsForEntity\x12$.qubic.transfers.proto.EntityRequest\x1a0.qubic.transfers.proto.AssetChangeEventsResponse\":\x82\xd3\xe4\x93\x024\x122/api/v1/entities/{identity}/events/asset-transfers\x12\xa3\x01\n" +
 	"\x1aGetQuTransferEventsForTick\x12\".qubic.transfers.proto.TickRequest\x1a/.qubic.transfers.proto.QuTransferEventsResponse\"0\x82\xd3\xe4\x93\x02*\x12(/api/v1/ticks/{tick}/events/qu-transfers\x12\xae\x01\n" +
-	"\x1cGetQuTransferEventsForEntity\x12$.qubic.transfers.proto.EntityRequest\x1a/.qubic.transfers.proto.QuTransferEventsResponse\"7\x82\xd3\xe4\x93\x021\x12//api/v1/entities/{identity}/events/qu-transfersB&Z$github.com/qubic/go-transfers/proto/b\x06proto3"
+	"\x1cGetQuTransferEventsForEntity\x12$.qubic.transfers.proto.EntityRequest\x1a/.qubic.transfers.proto.QuTransferEventsResponse\"7\x82\xd3\xe4\x93\x021\x12//api/v1/entities/{identity}/events/qu-transfers\x12\xa5\x01\n" +
+	"\x1aGetTransfersForTransaction\x12).qubic.transfers.proto.TransactionRequest\x1a0.qubic.transfers.proto.TransactionEventsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/transactions/{hash}/events\x12\x88\x01\n" +
+	"\x15GetEventsForTickRange\x12'.qubic.transfers.proto.TickRangeRequest\x1a..qubic.transfers.proto.TickRangeEventsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/events\x12\x9a\x01\n" +
+	"\x17GetTransfersForEntities\x12&.qubic.transfers.proto.EntitiesRequest\x1a0.qubic.transfers.proto.EntitiesTransfersResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/entities/transfers\x12v\n" +
+	"\x12SubscribeTransfers\x120.qubic.transfers.proto.SubscribeTransfersRequest\x1a,.qubic.transfers.proto.TickTransfersResponse0\x01\x12\xaa\x01\n" +
+	"\x1cGetQuBalanceHistoryForEntity\x12$.qubic.transfers.proto.EntityRequest\x1a/.qubic.transfers.proto.QuBalanceHistoryResponse\"3\x82\xd3\xe4\x93\x02-\x12+/api/v1/entities/{identity}/balance-history\x12\xad\x01\n" +
+	"\x1aGetCounterpartiesForEntity\x12,.qubic.transfers.proto.CounterpartiesRequest\x1a-.qubic.transfers.proto.CounterpartiesResponse\"2\x82\xd3\xe4\x93\x02,\x12*/api/v1/entities/{identity}/counterparties\x12\x8c\x01\n" +
+	"\n" +
+	"TraceFunds\x12(.qubic.transfers.proto.TraceFundsRequest\x1a).qubic.transfers.proto.TraceFundsResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/v1/entities/{identity}/trace\x12\x9d\x01\n" +
+	"\x19GetAssetHoldingsForEntity\x12&.qubic.transfers.proto.HoldingsRequest\x1a,.qubic.transfers.proto.AssetHoldingsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/entities/{identity}/assets\x12b\n" +
+	"\tGetAssets\x12\x16.google.protobuf.Empty\x1a%.qubic.transfers.proto.AssetsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/assets\x12\xba\x01\n" +
+	"\x1cGetAssetChangeEventsForAsset\x12).qubic.transfers.proto.AssetEventsRequest\x1a0.qubic.transfers.proto.AssetChangeEventsResponse\"=\x82\xd3\xe4\x93\x027\x125/api/v1/assets/{issuer}/{name}/events/asset-transfers\x12\x93\x01\n" +
+	"\x0fGetAssetHolders\x12#.qubic.transfers.proto.AssetRequest\x1a+.qubic.transfers.proto.AssetHoldersResponse\".\x82\xd3\xe4\x93\x02(\x12&/api/v1/assets/{issuer}/{name}/holders2\xf4\x04\n" +
+	"\fAdminService\x12\\\n" +
+	"\rCreateWebhook\x12+.qubic.transfers.proto.CreateWebhookRequest\x1a\x1e.qubic.transfers.proto.Webhook\x12N\n" +
+	"\vGetWebhooks\x12\x16.google.protobuf.Empty\x1a'.qubic.transfers.proto.WebhooksResponse\x12N\n" +
+	"\rDeleteWebhook\x12%.qubic.transfers.proto.WebhookRequest\x1a\x16.google.protobuf.Empty\x12o\n" +
+	"\x14GetWebhookDeliveries\x12%.qubic.transfers.proto.WebhookRequest\x1a0.qubic.transfers.proto.WebhookDeliveriesResponse\x12Y\n" +
+	"\fCreateApiKey\x12*.qubic.transfers.proto.CreateApiKeyRequest\x1a\x1d.qubic.transfers.proto.ApiKey\x12L\n" +
+	"\n" +
+	"GetApiKeys\x12\x16.google.protobuf.Empty\x1a&.qubic.transfers.proto.ApiKeysResponse\x12L\n" +
+	"\fDeleteApiKey\x12$.qubic.transfers.proto.ApiKeyRequest\x1a\x16.google.protobuf.EmptyB&Z$github.com/qubic/go-transfers/proto/b\x06proto3"
 
 var (
 	file_transfers_proto_rawDescOnce sync.Once
@@ -630,45 +3689,151 @@ func file_transfers_proto_rawDescGZIP() []byte {
 	return file_transfers_proto_rawDescData
 }
 
-var file_transfers_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_transfers_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_transfers_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_transfers_proto_goTypes = []any{
-	(*HealthResponse)(nil),            // 0: qubic.transfers.proto.HealthResponse
-	(*Component)(nil),                 // 1: qubic.transfers.proto.Component
-	(*TickRequest)(nil),               // 2: qubic.transfers.proto.TickRequest
-	(*EntityRequest)(nil),             // 3: qubic.transfers.proto.EntityRequest
-	(*AssetChangeEventsResponse)(nil), // 4: qubic.transfers.proto.AssetChangeEventsResponse
-	(*AssetEventsResponse)(nil),       // 5: qubic.transfers.proto.AssetEventsResponse
-	(*QuTransferEventsResponse)(nil),  // 6: qubic.transfers.proto.QuTransferEventsResponse
-	(*QuTransferEvent)(nil),           // 7: qubic.transfers.proto.QuTransferEvent
-	(*AssetChangeEvent)(nil),          // 8: qubic.transfers.proto.AssetChangeEvent
-	nil,                               // 9: qubic.transfers.proto.HealthResponse.ComponentsEntry
-	nil,                               // 10: qubic.transfers.proto.Component.DetailsEntry
-	(*emptypb.Empty)(nil),             // 11: google.protobuf.Empty
+	(Direction)(0),                    // 0: qubic.transfers.proto.Direction
+	(TraceDirection)(0),               // 1: qubic.transfers.proto.TraceDirection
+	(*HealthResponse)(nil),            // 2: qubic.transfers.proto.HealthResponse
+	(*Component)(nil),                 // 3: qubic.transfers.proto.Component
+	(*TickRequest)(nil),               // 4: qubic.transfers.proto.TickRequest
+	(*EntityRequest)(nil),             // 5: qubic.transfers.proto.EntityRequest
+	(*CounterpartiesRequest)(nil),     // 6: qubic.transfers.proto.CounterpartiesRequest
+	(*TraceFundsRequest)(nil),         // 7: qubic.transfers.proto.TraceFundsRequest
+	(*HoldingsRequest)(nil),           // 8: qubic.transfers.proto.HoldingsRequest
+	(*AssetRequest)(nil),              // 9: qubic.transfers.proto.AssetRequest
+	(*AssetEventsRequest)(nil),        // 10: qubic.transfers.proto.AssetEventsRequest
+	(*AssetKey)(nil),                  // 11: qubic.transfers.proto.AssetKey
+	(*SubscribeTransfersRequest)(nil), // 12: qubic.transfers.proto.SubscribeTransfersRequest
+	(*EntitiesRequest)(nil),           // 13: qubic.transfers.proto.EntitiesRequest
+	(*TickRangeRequest)(nil),          // 14: qubic.transfers.proto.TickRangeRequest
+	(*TransactionRequest)(nil),        // 15: qubic.transfers.proto.TransactionRequest
+	(*AssetChangeEventsResponse)(nil), // 16: qubic.transfers.proto.AssetChangeEventsResponse
+	(*AssetEventsResponse)(nil),       // 17: qubic.transfers.proto.AssetEventsResponse
+	(*QuTransferEventsResponse)(nil),  // 18: qubic.transfers.proto.QuTransferEventsResponse
+	(*TransactionEventsResponse)(nil), // 19: qubic.transfers.proto.TransactionEventsResponse
+	(*TickRangeEventsResponse)(nil),   // 20: qubic.transfers.proto.TickRangeEventsResponse
+	(*EntitiesTransfersResponse)(nil), // 21: qubic.transfers.proto.EntitiesTransfersResponse
+	(*EntityTransfers)(nil),           // 22: qubic.transfers.proto.EntityTransfers
+	(*TickTransfersResponse)(nil),     // 23: qubic.transfers.proto.TickTransfersResponse
+	(*QuBalanceHistoryResponse)(nil),  // 24: qubic.transfers.proto.QuBalanceHistoryResponse
+	(*QuBalanceChange)(nil),           // 25: qubic.transfers.proto.QuBalanceChange
+	(*CounterpartiesResponse)(nil),    // 26: qubic.transfers.proto.CounterpartiesResponse
+	(*Counterparty)(nil),              // 27: qubic.transfers.proto.Counterparty
+	(*CounterpartyVolume)(nil),        // 28: qubic.transfers.proto.CounterpartyVolume
+	(*TraceFundsResponse)(nil),        // 29: qubic.transfers.proto.TraceFundsResponse
+	(*TraceNode)(nil),                 // 30: qubic.transfers.proto.TraceNode
+	(*TraceEdge)(nil),                 // 31: qubic.transfers.proto.TraceEdge
+	(*AssetHoldingsResponse)(nil),     // 32: qubic.transfers.proto.AssetHoldingsResponse
+	(*AssetHolding)(nil),              // 33: qubic.transfers.proto.AssetHolding
+	(*AssetHoldersResponse)(nil),      // 34: qubic.transfers.proto.AssetHoldersResponse
+	(*AssetHolder)(nil),               // 35: qubic.transfers.proto.AssetHolder
+	(*AssetsResponse)(nil),            // 36: qubic.transfers.proto.AssetsResponse
+	(*Asset)(nil),                     // 37: qubic.transfers.proto.Asset
+	(*QuTransferEvent)(nil),           // 38: qubic.transfers.proto.QuTransferEvent
+	(*AssetChangeEvent)(nil),          // 39: qubic.transfers.proto.AssetChangeEvent
+	(*CreateWebhookRequest)(nil),      // 40: qubic.transfers.proto.CreateWebhookRequest
+	(*WebhookRequest)(nil),            // 41: qubic.transfers.proto.WebhookRequest
+	(*Webhook)(nil),                   // 42: qubic.transfers.proto.Webhook
+	(*WebhooksResponse)(nil),          // 43: qubic.transfers.proto.WebhooksResponse
+	(*WebhookDelivery)(nil),           // 44: qubic.transfers.proto.WebhookDelivery
+	(*WebhookDeliveriesResponse)(nil), // 45: qubic.transfers.proto.WebhookDeliveriesResponse
+	(*CreateApiKeyRequest)(nil),       // 46: qubic.transfers.proto.CreateApiKeyRequest
+	(*ApiKeyRequest)(nil),             // 47: qubic.transfers.proto.ApiKeyRequest
+	(*ApiKey)(nil),                    // 48: qubic.transfers.proto.ApiKey
+	(*ApiKeysResponse)(nil),           // 49: qubic.transfers.proto.ApiKeysResponse
+	nil,                               // 50: qubic.transfers.proto.HealthResponse.ComponentsEntry
+	nil,                               // 51: qubic.transfers.proto.Component.DetailsEntry
+	(*timestamppb.Timestamp)(nil),     // 52: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),             // 53: google.protobuf.Empty
 }
 var file_transfers_proto_depIdxs = []int32{
-	9,  // 0: qubic.transfers.proto.HealthResponse.components:type_name -> qubic.transfers.proto.HealthResponse.ComponentsEntry
-	10, // 1: qubic.transfers.proto.Component.details:type_name -> qubic.transfers.proto.Component.DetailsEntry
-	8,  // 2: qubic.transfers.proto.AssetChangeEventsResponse.events:type_name -> qubic.transfers.proto.AssetChangeEvent
-	8,  // 3: qubic.transfers.proto.AssetEventsResponse.changeEvents:type_name -> qubic.transfers.proto.AssetChangeEvent
-	7,  // 4: qubic.transfers.proto.QuTransferEventsResponse.events:type_name -> qubic.transfers.proto.QuTransferEvent
-	1,  // 5: qubic.transfers.proto.HealthResponse.ComponentsEntry.value:type_name -> qubic.transfers.proto.Component
-	11, // 6: qubic.transfers.proto.TransferService.Health:input_type -> google.protobuf.Empty
-	2,  // 7: qubic.transfers.proto.TransferService.GetAssetEventsForTick:input_type -> qubic.transfers.proto.TickRequest
-	2,  // 8: qubic.transfers.proto.TransferService.GetAssetChangeEventsForTick:input_type -> qubic.transfers.proto.TickRequest
-	3,  // 9: qubic.transfers.proto.TransferService.GetAssetChangeEventsForEntity:input_type -> qubic.transfers.proto.EntityRequest
-	2,  // 10: qubic.transfers.proto.TransferService.GetQuTransferEventsForTick:input_type -> qubic.transfers.proto.TickRequest
-	3,  // 11: qubic.transfers.proto.TransferService.GetQuTransferEventsForEntity:input_type -> qubic.transfers.proto.EntityRequest
-	0,  // 12: qubic.transfers.proto.TransferService.Health:output_type -> qubic.transfers.proto.HealthResponse
-	5,  // 13: qubic.transfers.proto.TransferService.GetAssetEventsForTick:output_type -> qubic.transfers.proto.AssetEventsResponse
-	4,  // 14: qubic.transfers.proto.TransferService.GetAssetChangeEventsForTick:output_type -> qubic.transfers.proto.AssetChangeEventsResponse
-	4,  // 15: qubic.transfers.proto.TransferService.GetAssetChangeEventsForEntity:output_type -> qubic.transfers.proto.AssetChangeEventsResponse
-	6,  // 16: qubic.transfers.proto.TransferService.GetQuTransferEventsForTick:output_type -> qubic.transfers.proto.QuTransferEventsResponse
-	6,  // 17: qubic.transfers.proto.TransferService.GetQuTransferEventsForEntity:output_type -> qubic.transfers.proto.QuTransferEventsResponse
-	12, // [12:18] is the sub-list for method output_type
-	6,  // [6:12] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	50, // 0: qubic.transfers.proto.HealthResponse.components:type_name -> qubic.transfers.proto.HealthResponse.ComponentsEntry
+	51, // 1: qubic.transfers.proto.Component.details:type_name -> qubic.transfers.proto.Component.DetailsEntry
+	52, // 2: qubic.transfers.proto.EntityRequest.from_time:type_name -> google.protobuf.Timestamp
+	52, // 3: qubic.transfers.proto.EntityRequest.to_time:type_name -> google.protobuf.Timestamp
+	0,  // 4: qubic.transfers.proto.EntityRequest.direction:type_name -> qubic.transfers.proto.Direction
+	1,  // 5: qubic.transfers.proto.TraceFundsRequest.direction:type_name -> qubic.transfers.proto.TraceDirection
+	11, // 6: qubic.transfers.proto.SubscribeTransfersRequest.assets:type_name -> qubic.transfers.proto.AssetKey
+	39, // 7: qubic.transfers.proto.AssetChangeEventsResponse.events:type_name -> qubic.transfers.proto.AssetChangeEvent
+	39, // 8: qubic.transfers.proto.AssetEventsResponse.changeEvents:type_name -> qubic.transfers.proto.AssetChangeEvent
+	38, // 9: qubic.transfers.proto.QuTransferEventsResponse.events:type_name -> qubic.transfers.proto.QuTransferEvent
+	38, // 10: qubic.transfers.proto.TransactionEventsResponse.quTransfers:type_name -> qubic.transfers.proto.QuTransferEvent
+	39, // 11: qubic.transfers.proto.TransactionEventsResponse.assetChanges:type_name -> qubic.transfers.proto.AssetChangeEvent
+	38, // 12: qubic.transfers.proto.TickRangeEventsResponse.quTransfers:type_name -> qubic.transfers.proto.QuTransferEvent
+	39, // 13: qubic.transfers.proto.TickRangeEventsResponse.assetChanges:type_name -> qubic.transfers.proto.AssetChangeEvent
+	22, // 14: qubic.transfers.proto.EntitiesTransfersResponse.entities:type_name -> qubic.transfers.proto.EntityTransfers
+	38, // 15: qubic.transfers.proto.EntityTransfers.quTransfers:type_name -> qubic.transfers.proto.QuTransferEvent
+	39, // 16: qubic.transfers.proto.EntityTransfers.assetChanges:type_name -> qubic.transfers.proto.AssetChangeEvent
+	38, // 17: qubic.transfers.proto.TickTransfersResponse.quTransfers:type_name -> qubic.transfers.proto.QuTransferEvent
+	39, // 18: qubic.transfers.proto.TickTransfersResponse.assetChanges:type_name -> qubic.transfers.proto.AssetChangeEvent
+	25, // 19: qubic.transfers.proto.QuBalanceHistoryResponse.changes:type_name -> qubic.transfers.proto.QuBalanceChange
+	27, // 20: qubic.transfers.proto.CounterpartiesResponse.counterparties:type_name -> qubic.transfers.proto.Counterparty
+	28, // 21: qubic.transfers.proto.Counterparty.volumes:type_name -> qubic.transfers.proto.CounterpartyVolume
+	30, // 22: qubic.transfers.proto.TraceFundsResponse.nodes:type_name -> qubic.transfers.proto.TraceNode
+	31, // 23: qubic.transfers.proto.TraceFundsResponse.edges:type_name -> qubic.transfers.proto.TraceEdge
+	33, // 24: qubic.transfers.proto.AssetHoldingsResponse.holdings:type_name -> qubic.transfers.proto.AssetHolding
+	35, // 25: qubic.transfers.proto.AssetHoldersResponse.holders:type_name -> qubic.transfers.proto.AssetHolder
+	37, // 26: qubic.transfers.proto.AssetsResponse.assets:type_name -> qubic.transfers.proto.Asset
+	11, // 27: qubic.transfers.proto.CreateWebhookRequest.assets:type_name -> qubic.transfers.proto.AssetKey
+	11, // 28: qubic.transfers.proto.Webhook.assets:type_name -> qubic.transfers.proto.AssetKey
+	42, // 29: qubic.transfers.proto.WebhooksResponse.webhooks:type_name -> qubic.transfers.proto.Webhook
+	44, // 30: qubic.transfers.proto.WebhookDeliveriesResponse.deliveries:type_name -> qubic.transfers.proto.WebhookDelivery
+	48, // 31: qubic.transfers.proto.ApiKeysResponse.keys:type_name -> qubic.transfers.proto.ApiKey
+	3,  // 32: qubic.transfers.proto.HealthResponse.ComponentsEntry.value:type_name -> qubic.transfers.proto.Component
+	53, // 33: qubic.transfers.proto.TransferService.Health:input_type -> google.protobuf.Empty
+	4,  // 34: qubic.transfers.proto.TransferService.GetAssetEventsForTick:input_type -> qubic.transfers.proto.TickRequest
+	4,  // 35: qubic.transfers.proto.TransferService.GetAssetChangeEventsForTick:input_type -> qubic.transfers.proto.TickRequest
+	5,  // 36: qubic.transfers.proto.TransferService.GetAssetChangeEventsForEntity:input_type -> qubic.transfers.proto.EntityRequest
+	4,  // 37: qubic.transfers.proto.TransferService.GetQuTransferEventsForTick:input_type -> qubic.transfers.proto.TickRequest
+	5,  // 38: qubic.transfers.proto.TransferService.GetQuTransferEventsForEntity:input_type -> qubic.transfers.proto.EntityRequest
+	15, // 39: qubic.transfers.proto.TransferService.GetTransfersForTransaction:input_type -> qubic.transfers.proto.TransactionRequest
+	14, // 40: qubic.transfers.proto.TransferService.GetEventsForTickRange:input_type -> qubic.transfers.proto.TickRangeRequest
+	13, // 41: qubic.transfers.proto.TransferService.GetTransfersForEntities:input_type -> qubic.transfers.proto.EntitiesRequest
+	12, // 42: qubic.transfers.proto.TransferService.SubscribeTransfers:input_type -> qubic.transfers.proto.SubscribeTransfersRequest
+	5,  // 43: qubic.transfers.proto.TransferService.GetQuBalanceHistoryForEntity:input_type -> qubic.transfers.proto.EntityRequest
+	6,  // 44: qubic.transfers.proto.TransferService.GetCounterpartiesForEntity:input_type -> qubic.transfers.proto.CounterpartiesRequest
+	7,  // 45: qubic.transfers.proto.TransferService.TraceFunds:input_type -> qubic.transfers.proto.TraceFundsRequest
+	8,  // 46: qubic.transfers.proto.TransferService.GetAssetHoldingsForEntity:input_type -> qubic.transfers.proto.HoldingsRequest
+	53, // 47: qubic.transfers.proto.TransferService.GetAssets:input_type -> google.protobuf.Empty
+	10, // 48: qubic.transfers.proto.TransferService.GetAssetChangeEventsForAsset:input_type -> qubic.transfers.proto.AssetEventsRequest
+	9,  // 49: qubic.transfers.proto.TransferService.GetAssetHolders:input_type -> qubic.transfers.proto.AssetRequest
+	40, // 50: qubic.transfers.proto.AdminService.CreateWebhook:input_type -> qubic.transfers.proto.CreateWebhookRequest
+	53, // 51: qubic.transfers.proto.AdminService.GetWebhooks:input_type -> google.protobuf.Empty
+	41, // 52: qubic.transfers.proto.AdminService.DeleteWebhook:input_type -> qubic.transfers.proto.WebhookRequest
+	41, // 53: qubic.transfers.proto.AdminService.GetWebhookDeliveries:input_type -> qubic.transfers.proto.WebhookRequest
+	46, // 54: qubic.transfers.proto.AdminService.CreateApiKey:input_type -> qubic.transfers.proto.CreateApiKeyRequest
+	53, // 55: qubic.transfers.proto.AdminService.GetApiKeys:input_type -> google.protobuf.Empty
+	47, // 56: qubic.transfers.proto.AdminService.DeleteApiKey:input_type -> qubic.transfers.proto.ApiKeyRequest
+	2,  // 57: qubic.transfers.proto.TransferService.Health:output_type -> qubic.transfers.proto.HealthResponse
+	17, // 58: qubic.transfers.proto.TransferService.GetAssetEventsForTick:output_type -> qubic.transfers.proto.AssetEventsResponse
+	16, // 59: qubic.transfers.proto.TransferService.GetAssetChangeEventsForTick:output_type -> qubic.transfers.proto.AssetChangeEventsResponse
+	16, // 60: qubic.transfers.proto.TransferService.GetAssetChangeEventsForEntity:output_type -> qubic.transfers.proto.AssetChangeEventsResponse
+	18, // 61: qubic.transfers.proto.TransferService.GetQuTransferEventsForTick:output_type -> qubic.transfers.proto.QuTransferEventsResponse
+	18, // 62: qubic.transfers.proto.TransferService.GetQuTransferEventsForEntity:output_type -> qubic.transfers.proto.QuTransferEventsResponse
+	19, // 63: qubic.transfers.proto.TransferService.GetTransfersForTransaction:output_type -> qubic.transfers.proto.TransactionEventsResponse
+	20, // 64: qubic.transfers.proto.TransferService.GetEventsForTickRange:output_type -> qubic.transfers.proto.TickRangeEventsResponse
+	21, // 65: qubic.transfers.proto.TransferService.GetTransfersForEntities:output_type -> qubic.transfers.proto.EntitiesTransfersResponse
+	23, // 66: qubic.transfers.proto.TransferService.SubscribeTransfers:output_type -> qubic.transfers.proto.TickTransfersResponse
+	24, // 67: qubic.transfers.proto.TransferService.GetQuBalanceHistoryForEntity:output_type -> qubic.transfers.proto.QuBalanceHistoryResponse
+	26, // 68: qubic.transfers.proto.TransferService.GetCounterpartiesForEntity:output_type -> qubic.transfers.proto.CounterpartiesResponse
+	29, // 69: qubic.transfers.proto.TransferService.TraceFunds:output_type -> qubic.transfers.proto.TraceFundsResponse
+	32, // 70: qubic.transfers.proto.TransferService.GetAssetHoldingsForEntity:output_type -> qubic.transfers.proto.AssetHoldingsResponse
+	36, // 71: qubic.transfers.proto.TransferService.GetAssets:output_type -> qubic.transfers.proto.AssetsResponse
+	16, // 72: qubic.transfers.proto.TransferService.GetAssetChangeEventsForAsset:output_type -> qubic.transfers.proto.AssetChangeEventsResponse
+	34, // 73: qubic.transfers.proto.TransferService.GetAssetHolders:output_type -> qubic.transfers.proto.AssetHoldersResponse
+	42, // 74: qubic.transfers.proto.AdminService.CreateWebhook:output_type -> qubic.transfers.proto.Webhook
+	43, // 75: qubic.transfers.proto.AdminService.GetWebhooks:output_type -> qubic.transfers.proto.WebhooksResponse
+	53, // 76: qubic.transfers.proto.AdminService.DeleteWebhook:output_type -> google.protobuf.Empty
+	45, // 77: qubic.transfers.proto.AdminService.GetWebhookDeliveries:output_type -> qubic.transfers.proto.WebhookDeliveriesResponse
+	48, // 78: qubic.transfers.proto.AdminService.CreateApiKey:output_type -> qubic.transfers.proto.ApiKey
+	49, // 79: qubic.transfers.proto.AdminService.GetApiKeys:output_type -> qubic.transfers.proto.ApiKeysResponse
+	53, // 80: qubic.transfers.proto.AdminService.DeleteApiKey:output_type -> google.protobuf.Empty
+	57, // [57:81] is the sub-list for method output_type
+	33, // [33:57] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_transfers_proto_init() }
@@ -681,13 +3846,14 @@ func file_transfers_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_transfers_proto_rawDesc), len(file_transfers_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      2,
+			NumMessages:   50,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_transfers_proto_goTypes,
 		DependencyIndexes: file_transfers_proto_depIdxs,
+		EnumInfos:         file_transfers_proto_enumTypes,
 		MessageInfos:      file_transfers_proto_msgTypes,
 	}.Build()
 	File_transfers_proto = out.File
@@ -27,6 +27,17 @@ const (
 	TransferService_GetAssetChangeEventsForEntity_FullMethodName = "/qubic.transfers.proto.TransferService/GetAssetChangeEventsForEntity"
 	TransferService_GetQuTransferEventsForTick_FullMethodName    = "/qubic.transfers.proto.TransferService/GetQuTransferEventsForTick"
 	TransferService_GetQuTransferEventsForEntity_FullMethodName  = "/qubic.transfers.proto.TransferService/GetQuTransferEventsForEntity"
+	TransferService_GetTransfersForTransaction_FullMethodName    = "/qubic.transfers.proto.TransferService/GetTransfersForTransaction"
+	TransferService_GetEventsForTickRange_FullMethodName         = "/qubic.transfers.proto.TransferService/GetEventsForTickRange"
+	TransferService_GetTransfersForEntities_FullMethodName       = "/qubic.transfers.proto.TransferService/GetTransfersForEntities"
+	TransferService_SubscribeTransfers_FullMethodName            = "/qubic.transfers.proto.TransferService/SubscribeTransfers"
+	TransferService_GetQuBalanceHistoryForEntity_FullMethodName  = "/qubic.transfers.proto.TransferService/GetQuBalanceHistoryForEntity"
+	TransferService_GetCounterpartiesForEntity_FullMethodName    = "/qubic.transfers.proto.TransferService/GetCounterpartiesForEntity"
+	TransferService_TraceFunds_FullMethodName                    = "/qubic.transfers.proto.TransferService/TraceFunds"
+	TransferService_GetAssetHoldingsForEntity_FullMethodName     = "/qubic.transfers.proto.TransferService/GetAssetHoldingsForEntity"
+	TransferService_GetAssets_FullMethodName                     = "/qubic.transfers.proto.TransferService/GetAssets"
+	TransferService_GetAssetChangeEventsForAsset_FullMethodName  = "/qubic.transfers.proto.TransferService/GetAssetChangeEventsForAsset"
+	TransferService_GetAssetHolders_FullMethodName               = "/qubic.transfers.proto.TransferService/GetAssetHolders"
 )
 
 // TransferServiceClient is the client API for TransferService service.
@@ -39,6 +50,18 @@ type TransferServiceClient interface {
 	GetAssetChangeEventsForEntity(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*AssetChangeEventsResponse, error)
 	GetQuTransferEventsForTick(ctx context.Context, in *TickRequest, opts ...grpc.CallOption) (*QuTransferEventsResponse, error)
 	GetQuTransferEventsForEntity(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*QuTransferEventsResponse, error)
+	GetTransfersForTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*TransactionEventsResponse, error)
+	GetEventsForTickRange(ctx context.Context, in *TickRangeRequest, opts ...grpc.CallOption) (*TickRangeEventsResponse, error)
+	GetTransfersForEntities(ctx context.Context, in *EntitiesRequest, opts ...grpc.CallOption) (*EntitiesTransfersResponse, error)
+	// replays the stored events from the start tick and then pushes the events of newly processed ticks.
+	SubscribeTransfers(ctx context.Context, in *SubscribeTransfersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TickTransfersResponse], error)
+	GetQuBalanceHistoryForEntity(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*QuBalanceHistoryResponse, error)
+	GetCounterpartiesForEntity(ctx context.Context, in *CounterpartiesRequest, opts ...grpc.CallOption) (*CounterpartiesResponse, error)
+	TraceFunds(ctx context.Context, in *TraceFundsRequest, opts ...grpc.CallOption) (*TraceFundsResponse, error)
+	GetAssetHoldingsForEntity(ctx context.Context, in *HoldingsRequest, opts ...grpc.CallOption) (*AssetHoldingsResponse, error)
+	GetAssets(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AssetsResponse, error)
+	GetAssetChangeEventsForAsset(ctx context.Context, in *AssetEventsRequest, opts ...grpc.CallOption) (*AssetChangeEventsResponse, error)
+	GetAssetHolders(ctx context.Context, in *AssetRequest, opts ...grpc.CallOption) (*AssetHoldersResponse, error)
 }
 
 type transferServiceClient struct {
@@ -109,6 +132,125 @@ func (c *transferServiceClient) GetQuTransferEventsForEntity(ctx context.Context
 	return out, nil
 }
 
+func (c *transferServiceClient) GetTransfersForTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*TransactionEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionEventsResponse)
+	err := c.cc.Invoke(ctx, TransferService_GetTransfersForTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transferServiceClient) GetEventsForTickRange(ctx context.Context, in *TickRangeRequest, opts ...grpc.CallOption) (*TickRangeEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TickRangeEventsResponse)
+	err := c.cc.Invoke(ctx, TransferService_GetEventsForTickRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transferServiceClient) GetTransfersForEntities(ctx context.Context, in *EntitiesRequest, opts ...grpc.CallOption) (*EntitiesTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EntitiesTransfersResponse)
+	err := c.cc.Invoke(ctx, TransferService_GetTransfersForEntities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transferServiceClient) SubscribeTransfers(ctx context.Context, in *SubscribeTransfersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TickTransfersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TransferService_ServiceDesc.Streams[0], TransferService_SubscribeTransfers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeTransfersRequest, TickTransfersResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TransferService_SubscribeTransfersClient = grpc.ServerStreamingClient[TickTransfersResponse]
+
+func (c *transferServiceClient) GetQuBalanceHistoryForEntity(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*QuBalanceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuBalanceHistoryResponse)
+	err := c.cc.Invoke(ctx, TransferService_GetQuBalanceHistoryForEntity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transferServiceClient) GetCounterpartiesForEntity(ctx context.Context, in *CounterpartiesRequest, opts ...grpc.CallOption) (*CounterpartiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CounterpartiesResponse)
+	err := c.cc.Invoke(ctx, TransferService_GetCounterpartiesForEntity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transferServiceClient) TraceFunds(ctx context.Context, in *TraceFundsRequest, opts ...grpc.CallOption) (*TraceFundsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TraceFundsResponse)
+	err := c.cc.Invoke(ctx, TransferService_TraceFunds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transferServiceClient) GetAssetHoldingsForEntity(ctx context.Context, in *HoldingsRequest, opts ...grpc.CallOption) (*AssetHoldingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssetHoldingsResponse)
+	err := c.cc.Invoke(ctx, TransferService_GetAssetHoldingsForEntity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transferServiceClient) GetAssets(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AssetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssetsResponse)
+	err := c.cc.Invoke(ctx, TransferService_GetAssets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transferServiceClient) GetAssetChangeEventsForAsset(ctx context.Context, in *AssetEventsRequest, opts ...grpc.CallOption) (*AssetChangeEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssetChangeEventsResponse)
+	err := c.cc.Invoke(ctx, TransferService_GetAssetChangeEventsForAsset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transferServiceClient) GetAssetHolders(ctx context.Context, in *AssetRequest, opts ...grpc.CallOption) (*AssetHoldersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssetHoldersResponse)
+	err := c.cc.Invoke(ctx, TransferService_GetAssetHolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransferServiceServer is the server API for TransferService service.
 // All implementations must embed UnimplementedTransferServiceServer
 // for forward compatibility.
@@ -119,6 +261,18 @@ type TransferServiceServer interface {
 	GetAssetChangeEventsForEntity(context.Context, *EntityRequest) (*AssetChangeEventsResponse, error)
 	GetQuTransferEventsForTick(context.Context, *TickRequest) (*QuTransferEventsResponse, error)
 	GetQuTransferEventsForEntity(context.Context, *EntityRequest) (*QuTransferEventsResponse, error)
+	GetTransfersForTransaction(context.Context, *TransactionRequest) (*TransactionEventsResponse, error)
+	GetEventsForTickRange(context.Context, *TickRangeRequest) (*TickRangeEventsResponse, error)
+	GetTransfersForEntities(context.Context, *EntitiesRequest) (*EntitiesTransfersResponse, error)
+	// replays the stored events from the start tick and then pushes the events of newly processed ticks.
+	SubscribeTransfers(*SubscribeTransfersRequest, grpc.ServerStreamingServer[TickTransfersResponse]) error
+	GetQuBalanceHistoryForEntity(context.Context, *EntityRequest) (*QuBalanceHistoryResponse, error)
+	GetCounterpartiesForEntity(context.Context, *CounterpartiesRequest) (*CounterpartiesResponse, error)
+	TraceFunds(context.Context, *TraceFundsRequest) (*TraceFundsResponse, error)
+	GetAssetHoldingsForEntity(context.Context, *HoldingsRequest) (*AssetHoldingsResponse, error)
+	GetAssets(context.Context, *emptypb.Empty) (*AssetsResponse, error)
+	GetAssetChangeEventsForAsset(context.Context, *AssetEventsRequest) (*AssetChangeEventsResponse, error)
+	GetAssetHolders(context.Context, *AssetRequest) (*AssetHoldersResponse, error)
 	mustEmbedUnimplementedTransferServiceServer()
 }
 
@@ -147,6 +301,39 @@ func (UnimplementedTransferServiceServer) GetQuTransferEventsForTick(context.Con
 func (UnimplementedTransferServiceServer) GetQuTransferEventsForEntity(context.Context, *EntityRequest) (*QuTransferEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetQuTransferEventsForEntity not implemented")
 }
+func (UnimplementedTransferServiceServer) GetTransfersForTransaction(context.Context, *TransactionRequest) (*TransactionEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransfersForTransaction not implemented")
+}
+func (UnimplementedTransferServiceServer) GetEventsForTickRange(context.Context, *TickRangeRequest) (*TickRangeEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventsForTickRange not implemented")
+}
+func (UnimplementedTransferServiceServer) GetTransfersForEntities(context.Context, *EntitiesRequest) (*EntitiesTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransfersForEntities not implemented")
+}
+func (UnimplementedTransferServiceServer) SubscribeTransfers(*SubscribeTransfersRequest, grpc.ServerStreamingServer[TickTransfersResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTransfers not implemented")
+}
+func (UnimplementedTransferServiceServer) GetQuBalanceHistoryForEntity(context.Context, *EntityRequest) (*QuBalanceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuBalanceHistoryForEntity not implemented")
+}
+func (UnimplementedTransferServiceServer) GetCounterpartiesForEntity(context.Context, *CounterpartiesRequest) (*CounterpartiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCounterpartiesForEntity not implemented")
+}
+func (UnimplementedTransferServiceServer) TraceFunds(context.Context, *TraceFundsRequest) (*TraceFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceFunds not implemented")
+}
+func (UnimplementedTransferServiceServer) GetAssetHoldingsForEntity(context.Context, *HoldingsRequest) (*AssetHoldingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssetHoldingsForEntity not implemented")
+}
+func (UnimplementedTransferServiceServer) GetAssets(context.Context, *emptypb.Empty) (*AssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssets not implemented")
+}
+func (UnimplementedTransferServiceServer) GetAssetChangeEventsForAsset(context.Context, *AssetEventsRequest) (*AssetChangeEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssetChangeEventsForAsset not implemented")
+}
+func (UnimplementedTransferServiceServer) GetAssetHolders(context.Context, *AssetRequest) (*AssetHoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssetHolders not implemented")
+}
 func (UnimplementedTransferServiceServer) mustEmbedUnimplementedTransferServiceServer() {}
 func (UnimplementedTransferServiceServer) testEmbeddedByValue()                         {}
 
@@ -276,6 +463,197 @@ func _TransferService_GetQuTransferEventsForEntity_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransferService_GetTransfersForTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).GetTransfersForTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransferService_GetTransfersForTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).GetTransfersForTransaction(ctx, req.(*TransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransferService_GetEventsForTickRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TickRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).GetEventsForTickRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransferService_GetEventsForTickRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).GetEventsForTickRange(ctx, req.(*TickRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransferService_GetTransfersForEntities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).GetTransfersForEntities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransferService_GetTransfersForEntities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).GetTransfersForEntities(ctx, req.(*EntitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransferService_SubscribeTransfers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeTransfersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransferServiceServer).SubscribeTransfers(m, &grpc.GenericServerStream[SubscribeTransfersRequest, TickTransfersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TransferService_SubscribeTransfersServer = grpc.ServerStreamingServer[TickTransfersResponse]
+
+func _TransferService_GetQuBalanceHistoryForEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).GetQuBalanceHistoryForEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransferService_GetQuBalanceHistoryForEntity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).GetQuBalanceHistoryForEntity(ctx, req.(*EntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransferService_GetCounterpartiesForEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CounterpartiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).GetCounterpartiesForEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransferService_GetCounterpartiesForEntity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).GetCounterpartiesForEntity(ctx, req.(*CounterpartiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransferService_TraceFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraceFundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).TraceFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransferService_TraceFunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).TraceFunds(ctx, req.(*TraceFundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransferService_GetAssetHoldingsForEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).GetAssetHoldingsForEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransferService_GetAssetHoldingsForEntity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).GetAssetHoldingsForEntity(ctx, req.(*HoldingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransferService_GetAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).GetAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransferService_GetAssets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).GetAssets(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransferService_GetAssetChangeEventsForAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).GetAssetChangeEventsForAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransferService_GetAssetChangeEventsForAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).GetAssetChangeEventsForAsset(ctx, req.(*AssetEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransferService_GetAssetHolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).GetAssetHolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransferService_GetAssetHolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).GetAssetHolders(ctx, req.(*AssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TransferService_ServiceDesc is the grpc.ServiceDesc for TransferService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -307,6 +685,386 @@ var TransferService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetQuTransferEventsForEntity",
 			Handler:    _TransferService_GetQuTransferEventsForEntity_Handler,
 		},
+		{
+			MethodName: "GetTransfersForTransaction",
+			Handler:    _TransferService_GetTransfersForTransaction_Handler,
+		},
+		{
+			MethodName: "GetEventsForTickRange",
+			Handler:    _TransferService_GetEventsForTickRange_Handler,
+		},
+		{
+			MethodName: "GetTransfersForEntities",
+			Handler:    _TransferService_GetTransfersForEntities_Handler,
+		},
+		{
+			MethodName: "GetQuBalanceHistoryForEntity",
+			Handler:    _TransferService_GetQuBalanceHistoryForEntity_Handler,
+		},
+		{
+			MethodName: "GetCounterpartiesForEntity",
+			Handler:    _TransferService_GetCounterpartiesForEntity_Handler,
+		},
+		{
+			MethodName: "TraceFunds",
+			Handler:    _TransferService_TraceFunds_Handler,
+		},
+		{
+			MethodName: "GetAssetHoldingsForEntity",
+			Handler:    _TransferService_GetAssetHoldingsForEntity_Handler,
+		},
+		{
+			MethodName: "GetAssets",
+			Handler:    _TransferService_GetAssets_Handler,
+		},
+		{
+			MethodName: "GetAssetChangeEventsForAsset",
+			Handler:    _TransferService_GetAssetChangeEventsForAsset_Handler,
+		},
+		{
+			MethodName: "GetAssetHolders",
+			Handler:    _TransferService_GetAssetHolders_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeTransfers",
+			Handler:       _TransferService_SubscribeTransfers_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "transfers.proto",
+}
+
+const (
+	AdminService_CreateWebhook_FullMethodName        = "/qubic.transfers.proto.AdminService/CreateWebhook"
+	AdminService_GetWebhooks_FullMethodName          = "/qubic.transfers.proto.AdminService/GetWebhooks"
+	AdminService_DeleteWebhook_FullMethodName        = "/qubic.transfers.proto.AdminService/DeleteWebhook"
+	AdminService_GetWebhookDeliveries_FullMethodName = "/qubic.transfers.proto.AdminService/GetWebhookDeliveries"
+	AdminService_CreateApiKey_FullMethodName         = "/qubic.transfers.proto.AdminService/CreateApiKey"
+	AdminService_GetApiKeys_FullMethodName           = "/qubic.transfers.proto.AdminService/GetApiKeys"
+	AdminService_DeleteApiKey_FullMethodName         = "/qubic.transfers.proto.AdminService/DeleteApiKey"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	GetWebhooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WebhooksResponse, error)
+	DeleteWebhook(ctx context.Context, in *WebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// latest delivery attempts first
+	GetWebhookDeliveries(ctx context.Context, in *WebhookRequest, opts ...grpc.CallOption) (*WebhookDeliveriesResponse, error)
+	// changes are applied by the api servers on the next refresh of the keys.
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*ApiKey, error)
+	GetApiKeys(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiKeysResponse, error)
+	DeleteApiKey(ctx context.Context, in *ApiKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, AdminService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetWebhooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhooksResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteWebhook(ctx context.Context, in *WebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AdminService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetWebhookDeliveries(ctx context.Context, in *WebhookRequest, opts ...grpc.CallOption) (*WebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*ApiKey, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiKey)
+	err := c.cc.Invoke(ctx, AdminService_CreateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetApiKeys(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApiKeysResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetApiKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteApiKey(ctx context.Context, in *ApiKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AdminService_DeleteApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+type AdminServiceServer interface {
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*Webhook, error)
+	GetWebhooks(context.Context, *emptypb.Empty) (*WebhooksResponse, error)
+	DeleteWebhook(context.Context, *WebhookRequest) (*emptypb.Empty, error)
+	// latest delivery attempts first
+	GetWebhookDeliveries(context.Context, *WebhookRequest) (*WebhookDeliveriesResponse, error)
+	// changes are applied by the api servers on the next refresh of the keys.
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*ApiKey, error)
+	GetApiKeys(context.Context, *emptypb.Empty) (*ApiKeysResponse, error)
+	DeleteApiKey(context.Context, *ApiKeyRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedAdminServiceServer) GetWebhooks(context.Context, *emptypb.Empty) (*WebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhooks not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteWebhook(context.Context, *WebhookRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedAdminServiceServer) GetWebhookDeliveries(context.Context, *WebhookRequest) (*WebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookDeliveries not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateApiKey(context.Context, *CreateApiKeyRequest) (*ApiKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (UnimplementedAdminServiceServer) GetApiKeys(context.Context, *emptypb.Empty) (*ApiKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApiKeys not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteApiKey(context.Context, *ApiKeyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApiKey not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetWebhooks(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteWebhook(ctx, req.(*WebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetWebhookDeliveries(ctx, req.(*WebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetApiKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetApiKeys(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteApiKey(ctx, req.(*ApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "qubic.transfers.proto.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _AdminService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhooks",
+			Handler:    _AdminService_GetWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _AdminService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhookDeliveries",
+			Handler:    _AdminService_GetWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _AdminService_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "GetApiKeys",
+			Handler:    _AdminService_GetApiKeys_Handler,
+		},
+		{
+			MethodName: "DeleteApiKey",
+			Handler:    _AdminService_DeleteApiKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "transfers.proto",
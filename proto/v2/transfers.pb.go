@@ -0,0 +1,866 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: v2/transfers.proto
+
+package v2
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EventType int32
+
+const (
+	EventType_EVENT_TYPE_UNSPECIFIED             EventType = 0
+	EventType_EVENT_TYPE_QU_TRANSFER             EventType = 1
+	EventType_EVENT_TYPE_ASSET_OWNERSHIP_CHANGE  EventType = 2
+	EventType_EVENT_TYPE_ASSET_POSSESSION_CHANGE EventType = 3
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0: "EVENT_TYPE_UNSPECIFIED",
+		1: "EVENT_TYPE_QU_TRANSFER",
+		2: "EVENT_TYPE_ASSET_OWNERSHIP_CHANGE",
+		3: "EVENT_TYPE_ASSET_POSSESSION_CHANGE",
+	}
+	EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED":             0,
+		"EVENT_TYPE_QU_TRANSFER":             1,
+		"EVENT_TYPE_ASSET_OWNERSHIP_CHANGE":  2,
+		"EVENT_TYPE_ASSET_POSSESSION_CHANGE": 3,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_v2_transfers_proto_enumTypes[0].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_v2_transfers_proto_enumTypes[0]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_v2_transfers_proto_rawDescGZIP(), []int{0}
+}
+
+type Direction int32
+
+const (
+	Direction_DIRECTION_UNSPECIFIED Direction = 0 // both
+	Direction_DIRECTION_INCOMING    Direction = 1
+	Direction_DIRECTION_OUTGOING    Direction = 2
+)
+
+// Enum value maps for Direction.
+var (
+	Direction_name = map[int32]string{
+		0: "DIRECTION_UNSPECIFIED",
+		1: "DIRECTION_INCOMING",
+		2: "DIRECTION_OUTGOING",
+	}
+	Direction_value = map[string]int32{
+		"DIRECTION_UNSPECIFIED": 0,
+		"DIRECTION_INCOMING":    1,
+		"DIRECTION_OUTGOING":    2,
+	}
+)
+
+func (x Direction) Enum() *Direction {
+	p := new(Direction)
+	*p = x
+	return p
+}
+
+func (x Direction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Direction) Descriptor() protoreflect.EnumDescriptor {
+	return file_v2_transfers_proto_enumTypes[1].Descriptor()
+}
+
+func (Direction) Type() protoreflect.EnumType {
+	return &file_v2_transfers_proto_enumTypes[1]
+}
+
+func (x Direction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Direction.Descriptor instead.
+func (Direction) EnumDescriptor() ([]byte, []int) {
+	return file_v2_transfers_proto_rawDescGZIP(), []int{1}
+}
+
+type QuTransfer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Destination   string                 `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	Amount        uint64                 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuTransfer) Reset() {
+	*x = QuTransfer{}
+	mi := &file_v2_transfers_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuTransfer) ProtoMessage() {}
+
+func (x *QuTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_transfers_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuTransfer.ProtoReflect.Descriptor instead.
+func (*QuTransfer) Descriptor() ([]byte, []int) {
+	return file_v2_transfers_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QuTransfer) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *QuTransfer) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *QuTransfer) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type AssetTransfer struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Source                  string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Destination             string                 `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	Issuer                  string                 `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Name                    string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	NumberOfShares          uint64                 `protobuf:"varint,5,opt,name=number_of_shares,json=numberOfShares,proto3" json:"number_of_shares,omitempty"`
+	NumberOfDecimals        uint32                 `protobuf:"varint,6,opt,name=number_of_decimals,json=numberOfDecimals,proto3" json:"number_of_decimals,omitempty"`                       // 0, if unknown
+	FormattedNumberOfShares string                 `protobuf:"bytes,7,opt,name=formatted_number_of_shares,json=formattedNumberOfShares,proto3" json:"formatted_number_of_shares,omitempty"` // number of shares with decimals
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *AssetTransfer) Reset() {
+	*x = AssetTransfer{}
+	mi := &file_v2_transfers_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetTransfer) ProtoMessage() {}
+
+func (x *AssetTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_transfers_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetTransfer.ProtoReflect.Descriptor instead.
+func (*AssetTransfer) Descriptor() ([]byte, []int) {
+	return file_v2_transfers_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AssetTransfer) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *AssetTransfer) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *AssetTransfer) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *AssetTransfer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssetTransfer) GetNumberOfShares() uint64 {
+	if x != nil {
+		return x.NumberOfShares
+	}
+	return 0
+}
+
+func (x *AssetTransfer) GetNumberOfDecimals() uint32 {
+	if x != nil {
+		return x.NumberOfDecimals
+	}
+	return 0
+}
+
+func (x *AssetTransfer) GetFormattedNumberOfShares() string {
+	if x != nil {
+		return x.FormattedNumberOfShares
+	}
+	return ""
+}
+
+// a transfer of qu or asset shares. the payload depends on the type.
+type TransferEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Tick            uint32                 `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	Epoch           uint32                 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`        // 0, if unknown
+	Timestamp       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // tick time. not set, if unknown.
+	TransactionHash string                 `protobuf:"bytes,4,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	EventId         uint64                 `protobuf:"varint,5,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Type            EventType              `protobuf:"varint,6,opt,name=type,proto3,enum=qubic.transfers.v2.EventType" json:"type,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*TransferEvent_QuTransfer
+	//	*TransferEvent_AssetOwnershipChange
+	//	*TransferEvent_AssetPossessionChange
+	Payload       isTransferEvent_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferEvent) Reset() {
+	*x = TransferEvent{}
+	mi := &file_v2_transfers_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferEvent) ProtoMessage() {}
+
+func (x *TransferEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_transfers_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferEvent.ProtoReflect.Descriptor instead.
+func (*TransferEvent) Descriptor() ([]byte, []int) {
+	return file_v2_transfers_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TransferEvent) GetTick() uint32 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *TransferEvent) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *TransferEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *TransferEvent) GetTransactionHash() string {
+	if x != nil {
+		return x.TransactionHash
+	}
+	return ""
+}
+
+func (x *TransferEvent) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *TransferEvent) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *TransferEvent) GetPayload() isTransferEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *TransferEvent) GetQuTransfer() *QuTransfer {
+	if x != nil {
+		if x, ok := x.Payload.(*TransferEvent_QuTransfer); ok {
+			return x.QuTransfer
+		}
+	}
+	return nil
+}
+
+func (x *TransferEvent) GetAssetOwnershipChange() *AssetTransfer {
+	if x != nil {
+		if x, ok := x.Payload.(*TransferEvent_AssetOwnershipChange); ok {
+			return x.AssetOwnershipChange
+		}
+	}
+	return nil
+}
+
+func (x *TransferEvent) GetAssetPossessionChange() *AssetTransfer {
+	if x != nil {
+		if x, ok := x.Payload.(*TransferEvent_AssetPossessionChange); ok {
+			return x.AssetPossessionChange
+		}
+	}
+	return nil
+}
+
+type isTransferEvent_Payload interface {
+	isTransferEvent_Payload()
+}
+
+type TransferEvent_QuTransfer struct {
+	QuTransfer *QuTransfer `protobuf:"bytes,10,opt,name=qu_transfer,json=quTransfer,proto3,oneof"`
+}
+
+type TransferEvent_AssetOwnershipChange struct {
+	AssetOwnershipChange *AssetTransfer `protobuf:"bytes,11,opt,name=asset_ownership_change,json=assetOwnershipChange,proto3,oneof"`
+}
+
+type TransferEvent_AssetPossessionChange struct {
+	AssetPossessionChange *AssetTransfer `protobuf:"bytes,12,opt,name=asset_possession_change,json=assetPossessionChange,proto3,oneof"`
+}
+
+func (*TransferEvent_QuTransfer) isTransferEvent_Payload() {}
+
+func (*TransferEvent_AssetOwnershipChange) isTransferEvent_Payload() {}
+
+func (*TransferEvent_AssetPossessionChange) isTransferEvent_Payload() {}
+
+type TickRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tick          uint32                 `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TickRequest) Reset() {
+	*x = TickRequest{}
+	mi := &file_v2_transfers_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TickRequest) ProtoMessage() {}
+
+func (x *TickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_transfers_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TickRequest.ProtoReflect.Descriptor instead.
+func (*TickRequest) Descriptor() ([]byte, []int) {
+	return file_v2_transfers_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TickRequest) GetTick() uint32 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+type TransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionRequest) Reset() {
+	*x = TransactionRequest{}
+	mi := &file_v2_transfers_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionRequest) ProtoMessage() {}
+
+func (x *TransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_transfers_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionRequest.ProtoReflect.Descriptor instead.
+func (*TransactionRequest) Descriptor() ([]byte, []int) {
+	return file_v2_transfers_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TransactionRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type EntityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identity      string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	FromTick      uint32                 `protobuf:"varint,2,opt,name=from_tick,json=fromTick,proto3" json:"from_tick,omitempty"`                     // inclusive, optional
+	ToTick        uint32                 `protobuf:"varint,3,opt,name=to_tick,json=toTick,proto3" json:"to_tick,omitempty"`                           // inclusive, optional
+	Direction     Direction              `protobuf:"varint,4,opt,name=direction,proto3,enum=qubic.transfers.v2.Direction" json:"direction,omitempty"` // optional. defaults to both.
+	Types         []EventType            `protobuf:"varint,5,rep,packed,name=types,proto3,enum=qubic.transfers.v2.EventType" json:"types,omitempty"`  // optional. defaults to all.
+	PageSize      uint32                 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                     // optional. defaults to 100, max 1000.
+	PageToken     string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                   // optional. next_page_token of the previous page.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntityRequest) Reset() {
+	*x = EntityRequest{}
+	mi := &file_v2_transfers_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityRequest) ProtoMessage() {}
+
+func (x *EntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_transfers_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityRequest.ProtoReflect.Descriptor instead.
+func (*EntityRequest) Descriptor() ([]byte, []int) {
+	return file_v2_transfers_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EntityRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *EntityRequest) GetFromTick() uint32 {
+	if x != nil {
+		return x.FromTick
+	}
+	return 0
+}
+
+func (x *EntityRequest) GetToTick() uint32 {
+	if x != nil {
+		return x.ToTick
+	}
+	return 0
+}
+
+func (x *EntityRequest) GetDirection() Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return Direction_DIRECTION_UNSPECIFIED
+}
+
+func (x *EntityRequest) GetTypes() []EventType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *EntityRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *EntityRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// events in event order
+type TransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LatestTick    uint32                 `protobuf:"varint,1,opt,name=latest_tick,json=latestTick,proto3" json:"latest_tick,omitempty"`
+	Events        []*TransferEvent       `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransfersResponse) Reset() {
+	*x = TransfersResponse{}
+	mi := &file_v2_transfers_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransfersResponse) ProtoMessage() {}
+
+func (x *TransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_transfers_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransfersResponse.ProtoReflect.Descriptor instead.
+func (*TransfersResponse) Descriptor() ([]byte, []int) {
+	return file_v2_transfers_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TransfersResponse) GetLatestTick() uint32 {
+	if x != nil {
+		return x.LatestTick
+	}
+	return 0
+}
+
+func (x *TransfersResponse) GetEvents() []*TransferEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// events latest first
+type EntityTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LatestTick    uint32                 `protobuf:"varint,1,opt,name=latest_tick,json=latestTick,proto3" json:"latest_tick,omitempty"`
+	Events        []*TransferEvent       `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty, if there are no more events.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntityTransfersResponse) Reset() {
+	*x = EntityTransfersResponse{}
+	mi := &file_v2_transfers_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityTransfersResponse) ProtoMessage() {}
+
+func (x *EntityTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_transfers_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityTransfersResponse.ProtoReflect.Descriptor instead.
+func (*EntityTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_v2_transfers_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EntityTransfersResponse) GetLatestTick() uint32 {
+	if x != nil {
+		return x.LatestTick
+	}
+	return 0
+}
+
+func (x *EntityTransfersResponse) GetEvents() []*TransferEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *EntityTransfersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_v2_transfers_proto protoreflect.FileDescriptor
+
+const file_v2_transfers_proto_rawDesc = "" +
+	"\n" +
+	"\x12v2/transfers.proto\x12\x12qubic.transfers.v2\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"^\n" +
+	"\n" +
+	"QuTransfer\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12 \n" +
+	"\vdestination\x18\x02 \x01(\tR\vdestination\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x04R\x06amount\"\x8a\x02\n" +
+	"\rAssetTransfer\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12 \n" +
+	"\vdestination\x18\x02 \x01(\tR\vdestination\x12\x16\n" +
+	"\x06issuer\x18\x03 \x01(\tR\x06issuer\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12(\n" +
+	"\x10number_of_shares\x18\x05 \x01(\x04R\x0enumberOfShares\x12,\n" +
+	"\x12number_of_decimals\x18\x06 \x01(\rR\x10numberOfDecimals\x12;\n" +
+	"\x1aformatted_number_of_shares\x18\a \x01(\tR\x17formattedNumberOfShares\"\xf2\x03\n" +
+	"\rTransferEvent\x12\x12\n" +
+	"\x04tick\x18\x01 \x01(\rR\x04tick\x12\x14\n" +
+	"\x05epoch\x18\x02 \x01(\rR\x05epoch\x128\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12)\n" +
+	"\x10transaction_hash\x18\x04 \x01(\tR\x0ftransactionHash\x12\x19\n" +
+	"\bevent_id\x18\x05 \x01(\x04R\aeventId\x121\n" +
+	"\x04type\x18\x06 \x01(\x0e2\x1d.qubic.transfers.v2.EventTypeR\x04type\x12A\n" +
+	"\vqu_transfer\x18\n" +
+	" \x01(\v2\x1e.qubic.transfers.v2.QuTransferH\x00R\n" +
+	"quTransfer\x12Y\n" +
+	"\x16asset_ownership_change\x18\v \x01(\v2!.qubic.transfers.v2.AssetTransferH\x00R\x14assetOwnershipChange\x12[\n" +
+	"\x17asset_possession_change\x18\f \x01(\v2!.qubic.transfers.v2.AssetTransferH\x00R\x15assetPossessionChangeB\t\n" +
+	"\apayload\"!\n" +
+	"\vTickRequest\x12\x12\n" +
+	"\x04tick\x18\x01 \x01(\rR\x04tick\"(\n" +
+	"\x12TransactionRequest\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\"\x8f\x02\n" +
+	"\rEntityRequest\x12\x1a\n" +
+	"\bidentity\x18\x01 \x01(\tR\bidentity\x12\x1b\n" +
+	"\tfrom_tick\x18\x02 \x01(\rR\bfromTick\x12\x17\n" +
+	"\ato_tick\x18\x03 \x01(\rR\x06toTick\x12;\n" +
+	"\tdirection\x18\x04 \x01(\x0e2\x1d.qubic.transfers.v2.DirectionR\tdirection\x123\n" +
+	"\x05types\x18\x05 \x03(\x0e2\x1d.qubic.transfers.v2.EventTypeR\x05types\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\"o\n" +
+	"\x11TransfersResponse\x12\x1f\n" +
+	"\vlatest_tick\x18\x01 \x01(\rR\n" +
+	"latestTick\x129\n" +
+	"\x06events\x18\x02 \x03(\v2!.qubic.transfers.v2.TransferEventR\x06events\"\x9d\x01\n" +
+	"\x17EntityTransfersResponse\x12\x1f\n" +
+	"\vlatest_tick\x18\x01 \x01(\rR\n" +
+	"latestTick\x129\n" +
+	"\x06events\x18\x02 \x03(\v2!.qubic.transfers.v2.TransferEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken*\x92\x01\n" +
+	"\tEventType\x12\x1a\n" +
+	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16EVENT_TYPE_QU_TRANSFER\x10\x01\x12%\n" +
+	"!EVENT_TYPE_ASSET_OWNERSHIP_CHANGE\x10\x02\x12&\n" +
+	"\"EVENT_TYPE_ASSET_POSSESSION_CHANGE\x10\x03*V\n" +
+	"\tDirection\x12\x19\n" +
+	"\x15DIRECTION_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12DIRECTION_INCOMING\x10\x01\x12\x16\n" +
+	"\x12DIRECTION_OUTGOING\x10\x022\xcf\x03\n" +
+	"\x0fTransferService\x12\x85\x01\n" +
+	"\x13GetTransfersForTick\x12\x1f.qubic.transfers.v2.TickRequest\x1a%.qubic.transfers.v2.TransfersResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v2/ticks/{tick}/transfers\x12\x9a\x01\n" +
+	"\x1aGetTransfersForTransaction\x12&.qubic.transfers.v2.TransactionRequest\x1a%.qubic.transfers.v2.TransfersResponse\"-\x82\xd3\xe4\x93\x02'\x12%/api/v2/transactions/{hash}/transfers\x12\x96\x01\n" +
+	"\x15GetTransfersForEntity\x12!.qubic.transfers.v2.EntityRequest\x1a+.qubic.transfers.v2.EntityTransfersResponse\"-\x82\xd3\xe4\x93\x02'\x12%/api/v2/entities/{identity}/transfersB+Z)github.com/qubic/go-transfers/proto/v2;v2b\x06proto3"
+
+var (
+	file_v2_transfers_proto_rawDescOnce sync.Once
+	file_v2_transfers_proto_rawDescData []byte
+)
+
+func file_v2_transfers_proto_rawDescGZIP() []byte {
+	file_v2_transfers_proto_rawDescOnce.Do(func() {
+		file_v2_transfers_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v2_transfers_proto_rawDesc), len(file_v2_transfers_proto_rawDesc)))
+	})
+	return file_v2_transfers_proto_rawDescData
+}
+
+var file_v2_transfers_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_v2_transfers_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_v2_transfers_proto_goTypes = []any{
+	(EventType)(0),                  // 0: qubic.transfers.v2.EventType
+	(Direction)(0),                  // 1: qubic.transfers.v2.Direction
+	(*QuTransfer)(nil),              // 2: qubic.transfers.v2.QuTransfer
+	(*AssetTransfer)(nil),           // 3: qubic.transfers.v2.AssetTransfer
+	(*TransferEvent)(nil),           // 4: qubic.transfers.v2.TransferEvent
+	(*TickRequest)(nil),             // 5: qubic.transfers.v2.TickRequest
+	(*TransactionRequest)(nil),      // 6: qubic.transfers.v2.TransactionRequest
+	(*EntityRequest)(nil),           // 7: qubic.transfers.v2.EntityRequest
+	(*TransfersResponse)(nil),       // 8: qubic.transfers.v2.TransfersResponse
+	(*EntityTransfersResponse)(nil), // 9: qubic.transfers.v2.EntityTransfersResponse
+	(*timestamppb.Timestamp)(nil),   // 10: google.protobuf.Timestamp
+}
+var file_v2_transfers_proto_depIdxs = []int32{
+	10, // 0: qubic.transfers.v2.TransferEvent.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 1: qubic.transfers.v2.TransferEvent.type:type_name -> qubic.transfers.v2.EventType
+	2,  // 2: qubic.transfers.v2.TransferEvent.qu_transfer:type_name -> qubic.transfers.v2.QuTransfer
+	3,  // 3: qubic.transfers.v2.TransferEvent.asset_ownership_change:type_name -> qubic.transfers.v2.AssetTransfer
+	3,  // 4: qubic.transfers.v2.TransferEvent.asset_possession_change:type_name -> qubic.transfers.v2.AssetTransfer
+	1,  // 5: qubic.transfers.v2.EntityRequest.direction:type_name -> qubic.transfers.v2.Direction
+	0,  // 6: qubic.transfers.v2.EntityRequest.types:type_name -> qubic.transfers.v2.EventType
+	4,  // 7: qubic.transfers.v2.TransfersResponse.events:type_name -> qubic.transfers.v2.TransferEvent
+	4,  // 8: qubic.transfers.v2.EntityTransfersResponse.events:type_name -> qubic.transfers.v2.TransferEvent
+	5,  // 9: qubic.transfers.v2.TransferService.GetTransfersForTick:input_type -> qubic.transfers.v2.TickRequest
+	6,  // 10: qubic.transfers.v2.TransferService.GetTransfersForTransaction:input_type -> qubic.transfers.v2.TransactionRequest
+	7,  // 11: qubic.transfers.v2.TransferService.GetTransfersForEntity:input_type -> qubic.transfers.v2.EntityRequest
+	8,  // 12: qubic.transfers.v2.TransferService.GetTransfersForTick:output_type -> qubic.transfers.v2.TransfersResponse
+	8,  // 13: qubic.transfers.v2.TransferService.GetTransfersForTransaction:output_type -> qubic.transfers.v2.TransfersResponse
+	9,  // 14: qubic.transfers.v2.TransferService.GetTransfersForEntity:output_type -> qubic.transfers.v2.EntityTransfersResponse
+	12, // [12:15] is the sub-list for method output_type
+	9,  // [9:12] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_v2_transfers_proto_init() }
+func file_v2_transfers_proto_init() {
+	if File_v2_transfers_proto != nil {
+		return
+	}
+	file_v2_transfers_proto_msgTypes[2].OneofWrappers = []any{
+		(*TransferEvent_QuTransfer)(nil),
+		(*TransferEvent_AssetOwnershipChange)(nil),
+		(*TransferEvent_AssetPossessionChange)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v2_transfers_proto_rawDesc), len(file_v2_transfers_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v2_transfers_proto_goTypes,
+		DependencyIndexes: file_v2_transfers_proto_depIdxs,
+		EnumInfos:         file_v2_transfers_proto_enumTypes,
+		MessageInfos:      file_v2_transfers_proto_msgTypes,
+	}.Build()
+	File_v2_transfers_proto = out.File
+	file_v2_transfers_proto_goTypes = nil
+	file_v2_transfers_proto_depIdxs = nil
+}
@@ -3,7 +3,9 @@ package sync
 import (
 	"context"
 	"encoding/base64"
+	"go-transfers/db"
 	"strings"
+	"time"
 
 	"github.com/gookit/slog"
 	"github.com/pkg/errors"
@@ -16,12 +18,18 @@ const AAA = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"
 type EventRepository interface {
 	GetOrCreateEntity(ctx context.Context, identity string) (int, error)
 	GetOrCreateAsset(ctx context.Context, issuer, name string) (int, error)
-	GetOrCreateTick(ctx context.Context, tickNumber uint32) (int, error)
+	UpdateAssetDecimals(ctx context.Context, assetId int, numberOfDecimals uint32, unitOfMeasurement string) error
+	GetOrCreateTick(ctx context.Context, tickNumber uint32, timestamp time.Time, epoch uint32) (int, error)
 	GetOrCreateTransaction(ctx context.Context, hash string, tickId int) (int, error)
 	GetOrCreateEvent(ctx context.Context, transactionId int, eventEventId uint64, eventType uint32, eventData string) (int, error)
 	GetOrCreateQuTransferEvent(ctx context.Context, eventId int, sourceEntityId int, destinationEntityId int, amount uint64) (int, error)
-	GetOrCreateAssetChangeEvent(ctx context.Context, eventId, assetId, sourceEntityId, destinationEntityId int, numberOfShares int64) (int, error)
+	GetOrCreateAssetChangeEvent(ctx context.Context, eventId, assetId, sourceEntityId, destinationEntityId int, numberOfShares uint64) (int, error)
+	GetOrCreateQuBalanceChange(ctx context.Context, eventId, entityId int, delta db.Delta) (int, error)
+	UpdateAssetOwnership(ctx context.Context, eventId, assetId, entityId int, delta db.Delta) error
+	UpdateAssetPossession(ctx context.Context, eventId, assetId, entityId int, delta db.Delta) error
 	GetOrCreateAssetIssuanceEvent(ctx context.Context, eventId int, assetId int, numberOfShares int64, unitOfMeasurement string, numberOfDecimalPlaces uint32) (int, error)
+	CommitTick(ctx context.Context, tickNumber int) error
+	InTransaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type EventProcessor struct {
@@ -35,7 +43,24 @@ func NewEventProcessor(repository EventRepository) *EventProcessor {
 	return &ep
 }
 
-func (ep *EventProcessor) ProcessTickEvents(ctx context.Context, tickEvents *eventspb.TickEvents) (int, error) {
+// ProcessTick stores the relevant events of the tick and marks the tick as processed. Events, balances, holdings and
+// the webhook notifications for the events are stored in one transaction, so that nothing is lost or duplicated, if
+// processing fails.
+func (ep *EventProcessor) ProcessTick(ctx context.Context, tickNumber int, tickEvents *eventspb.TickEvents, tickTime time.Time, epoch uint32) (int, error) {
+	var count int
+	err := ep.repository.InTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		count, err = ep.ProcessTickEvents(ctx, tickEvents, tickTime, epoch)
+		if err != nil {
+			return err
+		}
+		return errors.Wrap(ep.repository.CommitTick(ctx, tickNumber), "committing tick")
+	})
+	return count, err
+}
+
+// ProcessTickEvents stores the relevant events of the tick. The tick time and epoch are stored with the tick, if known.
+func (ep *EventProcessor) ProcessTickEvents(ctx context.Context, tickEvents *eventspb.TickEvents, tickTime time.Time, epoch uint32) (int, error) {
 
 	var count int
 	for _, transactionEvents := range tickEvents.TxEvents {
@@ -46,7 +71,7 @@ func (ep *EventProcessor) ProcessTickEvents(ctx context.Context, tickEvents *eve
 
 			slog.Debug("Processing events of transaction.", "hash", transactionEvents.TxId, "count", len(relevantEvents))
 
-			transactionId, err := ep.getOrCreateTransaction(ctx, tickEvents.GetTick(), transactionEvents.GetTxId())
+			transactionId, err := ep.getOrCreateTransaction(ctx, tickEvents.GetTick(), tickTime, epoch, transactionEvents.GetTxId())
 			if err != nil {
 				return 0, errors.Wrap(err, "storing transaction")
 			}
@@ -86,16 +111,16 @@ func (ep *EventProcessor) ProcessTickEvents(ctx context.Context, tickEvents *eve
 	return count, nil
 }
 
-func (ep *EventProcessor) getTransactionId(ctx context.Context, tickNumber uint32, hash string) (int, error) {
-	transactionId, err := ep.getOrCreateTransaction(ctx, tickNumber, hash)
+func (ep *EventProcessor) getTransactionId(ctx context.Context, tickNumber uint32, tickTime time.Time, epoch uint32, hash string) (int, error) {
+	transactionId, err := ep.getOrCreateTransaction(ctx, tickNumber, tickTime, epoch, hash)
 	if err != nil {
 		return -1, errors.Wrap(err, "storing transaction")
 	}
 	return transactionId, nil
 }
 
-func (ep *EventProcessor) getOrCreateTransaction(ctx context.Context, tick uint32, transactionHash string) (int, error) {
-	tickId, err := ep.repository.GetOrCreateTick(ctx, tick)
+func (ep *EventProcessor) getOrCreateTransaction(ctx context.Context, tick uint32, tickTime time.Time, epoch uint32, transactionHash string) (int, error) {
+	tickId, err := ep.repository.GetOrCreateTick(ctx, tick, tickTime, epoch)
 	if err != nil {
 		return -1, errors.Wrap(err, "storing tick")
 	}
@@ -134,6 +159,10 @@ func (ep *EventProcessor) storeAssetPossessionChangeEvent(ctx context.Context, e
 		return -1, errors.Wrap(err, "decoding asset possession change")
 	}
 	assetChangeEvent := decodedEvent.GetAssetPossessionChangeEvent()
+	if assetChangeEvent.GetNumberOfShares() < 0 {
+		return -1, errors.Errorf("negative number of shares [%d]", assetChangeEvent.GetNumberOfShares())
+	}
+	numberOfShares := uint64(assetChangeEvent.GetNumberOfShares())
 	sourceId, err := ep.repository.GetOrCreateEntity(ctx, assetChangeEvent.GetSourceId())
 	if err != nil {
 		return -1, errors.Wrap(err, "storing asset possession change")
@@ -146,12 +175,21 @@ func (ep *EventProcessor) storeAssetPossessionChangeEvent(ctx context.Context, e
 	if err != nil {
 		return -1, errors.Wrap(err, "storing asset possession change")
 	}
-	assetChangeEventId, err := ep.repository.GetOrCreateAssetChangeEvent(ctx, eventId, assetId, sourceId, destinationId, assetChangeEvent.GetNumberOfShares())
+	err = ep.repository.UpdateAssetDecimals(ctx, assetId, assetChangeEvent.GetNumberOfDecimals(),
+		base64.StdEncoding.EncodeToString(assetChangeEvent.GetMeasurementUnit()))
+	if err != nil {
+		return -1, errors.Wrap(err, "storing asset possession change")
+	}
+	assetChangeEventId, err := ep.repository.GetOrCreateAssetChangeEvent(ctx, eventId, assetId, sourceId, destinationId, numberOfShares)
 	if err != nil {
 		return -1, errors.Wrap(err, "storing asset possession change")
 	} else {
 		slog.Debug("Stored asset possession change event.", "id", assetChangeEventId)
 	}
+	err = updateAssetHoldings(ctx, ep.repository.UpdateAssetPossession, eventId, assetId, sourceId, destinationId, numberOfShares)
+	if err != nil {
+		return -1, errors.Wrap(err, "updating asset possessions")
+	}
 	return assetChangeEventId, nil
 }
 
@@ -161,6 +199,10 @@ func (ep *EventProcessor) storeAssetOwnershipChangeEvent(ctx context.Context, ev
 		return -1, errors.Wrap(err, "decoding asset ownership change")
 	}
 	assetChangeEvent := decodedEvent.GetAssetOwnershipChangeEvent()
+	if assetChangeEvent.GetNumberOfShares() < 0 {
+		return -1, errors.Errorf("negative number of shares [%d]", assetChangeEvent.GetNumberOfShares())
+	}
+	numberOfShares := uint64(assetChangeEvent.GetNumberOfShares())
 	sourceId, err := ep.repository.GetOrCreateEntity(ctx, assetChangeEvent.GetSourceId())
 	if err != nil {
 		return -1, errors.Wrap(err, "storing asset ownership change")
@@ -173,12 +215,21 @@ func (ep *EventProcessor) storeAssetOwnershipChangeEvent(ctx context.Context, ev
 	if err != nil {
 		return -1, errors.Wrap(err, "storing asset ownership change")
 	}
-	assetChangeEventId, err := ep.repository.GetOrCreateAssetChangeEvent(ctx, eventId, assetId, sourceId, destinationId, assetChangeEvent.GetNumberOfShares())
+	err = ep.repository.UpdateAssetDecimals(ctx, assetId, assetChangeEvent.GetNumberOfDecimals(),
+		base64.StdEncoding.EncodeToString(assetChangeEvent.GetMeasurementUnit()))
+	if err != nil {
+		return -1, errors.Wrap(err, "storing asset ownership change")
+	}
+	assetChangeEventId, err := ep.repository.GetOrCreateAssetChangeEvent(ctx, eventId, assetId, sourceId, destinationId, numberOfShares)
 	if err != nil {
 		return -1, errors.Wrap(err, "storing asset ownership change")
 	} else {
 		slog.Debug("Stored asset ownership change event.", "id", assetChangeEventId)
 	}
+	err = updateAssetHoldings(ctx, ep.repository.UpdateAssetOwnership, eventId, assetId, sourceId, destinationId, numberOfShares)
+	if err != nil {
+		return -1, errors.Wrap(err, "updating asset ownerships")
+	}
 	return assetChangeEventId, nil
 }
 
@@ -204,9 +255,47 @@ func (ep *EventProcessor) storeQuTransferEvent(ctx context.Context, eventData []
 	} else {
 		slog.Debug("Stored qu transfer event.", "id", transferId)
 	}
+
+	err = ep.storeQuBalanceChanges(ctx, eventId, sourceId, destinationId, transferEvent.GetAmount())
+	if err != nil {
+		return -1, errors.Wrap(err, "storing qu balance changes")
+	}
 	return transferId, nil
 }
 
+// storeQuBalanceChanges updates the running balance of source and destination. Transfers to self do not change the balance.
+func (ep *EventProcessor) storeQuBalanceChanges(ctx context.Context, eventId, sourceId, destinationId int, amount uint64) error {
+	if sourceId == destinationId {
+		return nil
+	}
+	_, err := ep.repository.GetOrCreateQuBalanceChange(ctx, eventId, sourceId, db.Debit(amount))
+	if err != nil {
+		return errors.Wrap(err, "storing source balance change")
+	}
+	_, err = ep.repository.GetOrCreateQuBalanceChange(ctx, eventId, destinationId, db.Credit(amount))
+	if err != nil {
+		return errors.Wrap(err, "storing destination balance change")
+	}
+	return nil
+}
+
+// updateAssetHoldings moves the shares from source to destination. Transfers to self do not change the holdings.
+func updateAssetHoldings(ctx context.Context, update func(ctx context.Context, eventId, assetId, entityId int, delta db.Delta) error,
+	eventId, assetId, sourceId, destinationId int, numberOfShares uint64) error {
+	if sourceId == destinationId {
+		return nil
+	}
+	err := update(ctx, eventId, assetId, sourceId, db.Debit(numberOfShares))
+	if err != nil {
+		return errors.Wrap(err, "updating source holding")
+	}
+	err = update(ctx, eventId, assetId, destinationId, db.Credit(numberOfShares))
+	if err != nil {
+		return errors.Wrap(err, "updating destination holding")
+	}
+	return nil
+}
+
 func filterRelevantEvents(events []*eventspb.Event) []*eventspb.Event {
 	var filtered []*eventspb.Event
 	for _, ev := range events {
@@ -15,11 +15,12 @@ type EventClient interface {
 	GetEvents(ctx context.Context, tickNumber uint32) (*eventspb.TickEvents, error)
 	GetStatus(ctx context.Context) (*client.EventStatus, error)
 	GetTickInfo(ctx context.Context) (*client.TickInfo, error)
+	GetTickData(ctx context.Context, tickNumber uint32) (*client.TickData, error)
 }
 
 type TickNumberRepository interface {
 	GetLatestTick(ctx context.Context) (int, error)
-	UpdateLatestTick(ctx context.Context, tickNumber int) error
+	UpdateEpochStartTick(ctx context.Context, tickNumber int) error
 }
 
 type Metrics interface {
@@ -28,19 +29,26 @@ type Metrics interface {
 	SetLatestLiveTick(tick uint32)
 }
 
+type TickPublisher interface {
+	Publish(tick uint32)
+}
+
 type EventService struct {
 	client         EventClient
 	eventProcessor *EventProcessor
 	repository     TickNumberRepository
 	metrics        Metrics
+	publisher      TickPublisher
+	epochStartTick uint32 // last stored initial tick
 }
 
-func NewEventService(c EventClient, ep *EventProcessor, r TickNumberRepository, m Metrics) (*EventService, error) {
+func NewEventService(c EventClient, ep *EventProcessor, r TickNumberRepository, m Metrics, p TickPublisher) (*EventService, error) {
 	es := EventService{
 		client:         c,
 		eventProcessor: ep,
 		repository:     r,
 		metrics:        m,
+		publisher:      p,
 	}
 	return &es, nil
 }
@@ -105,6 +113,14 @@ func (es *EventService) calculateStartTick(ctx context.Context) (int, int, error
 		return -1, -1, errors.Wrap(err, "getting tick info")
 	}
 
+	if tickInfo.InitialTick != es.epochStartTick {
+		err = es.repository.UpdateEpochStartTick(ctx, int(tickInfo.InitialTick))
+		if err != nil {
+			return -1, -1, errors.Wrap(err, "updating epoch start tick")
+		}
+		es.epochStartTick = tickInfo.InitialTick
+	}
+
 	if int(tickInfo.InitialTick) > processedTick {
 		slog.Info("initial tick > processed tick", "initial", tickInfo.InitialTick, "processed", processedTick)
 	}
@@ -133,16 +149,22 @@ func (es *EventService) processTickEvents(ctx context.Context, tick int) error {
 		return errors.Wrapf(err, "getting events for tick [%d]", tick)
 	}
 
-	eventCount, err := es.eventProcessor.ProcessTickEvents(ctx, tickEvents)
-	if err != nil {
-		return errors.Wrapf(err, "processing events for tick [%d]", tick)
+	var tickTime time.Time
+	var epoch uint32
+	if len(tickEvents.GetTxEvents()) > 0 { // only needed, if there is something to store
+		tickData, err := es.client.GetTickData(ctx, uint32(tick))
+		if err != nil {
+			return errors.Wrapf(err, "getting tick data for tick [%d]", tick)
+		}
+		tickTime, epoch = tickData.Timestamp, tickData.Epoch
 	}
 
-	err = es.repository.UpdateLatestTick(ctx, tick)
+	eventCount, err := es.eventProcessor.ProcessTick(ctx, tick, tickEvents, tickTime, epoch)
 	if err != nil {
-		return errors.Wrapf(err, "updating latest tick to [%d]", tick)
+		return errors.Wrapf(err, "processing events for tick [%d]", tick)
 	}
 	es.metrics.SetLatestProcessedTick(uint32(tick))
+	es.publisher.Publish(uint32(tick)) // notify after commit
 
 	var numberOfTransactionEvents, numberOfTotalEvents int
 	for _, txEv := range tickEvents.TxEvents {
@@ -6,6 +6,7 @@ package sync
 import (
 	"context"
 	"flag"
+	"go-transfers/broadcast"
 	"go-transfers/client"
 	"go-transfers/db"
 	"os"
@@ -71,7 +72,7 @@ func setup() {
 		slog.Error("getting config", "err", err)
 		os.Exit(-1)
 	}
-	eventClient, err = client.NewIntegrationEventClient(config.Client.EventApiUrl, config.Client.CoreApiUrl)
+	eventClient, err = client.NewIntegrationEventClient(config.Client.EventApiUrl, config.Client.CoreApiUrl, nil)
 	if err != nil {
 		slog.Error("creating event client")
 		os.Exit(-1)
@@ -80,7 +81,7 @@ func setup() {
 	repository = db.NewRepository(setupDatabase(context.Background()))
 	eventProcessor := NewEventProcessor(repository)
 	meters := &FakeMetrics{}
-	eventService, err = NewEventService(eventClient, eventProcessor, repository, meters)
+	eventService, err = NewEventService(eventClient, eventProcessor, repository, meters, broadcast.NewTickBroadcaster())
 	if err != nil {
 		slog.Error("error creating event service")
 		os.Exit(-1)
@@ -2,22 +2,32 @@ package sync
 
 import (
 	"context"
+	"encoding/base64"
 	"github.com/gookit/slog"
 	eventspb "github.com/qubic/go-events/proto"
 	"github.com/stretchr/testify/assert"
 	"go-transfers/client"
+	"go-transfers/db"
+	"math"
 	"math/rand/v2"
 	"testing"
+	"time"
 )
 
 var (
-	processedTestTick             = 0
-	eventTick                     = 0
-	liveTick                      = 0
-	storedQuTransferEvents        = 0
-	metricProcessedTick    uint32 = 0
-	metricEventTick        uint32 = 0
-	metricLiveTick         uint32 = 0
+	processedTestTick               = 0
+	eventTick                       = 0
+	liveTick                        = 0
+	storedQuTransferEvents          = 0
+	storedQuBalanceChanges          = 0
+	metricProcessedTick      uint32 = 0
+	metricEventTick          uint32 = 0
+	metricLiveTick           uint32 = 0
+	publishedTicks           []uint32
+	storedEpochStartTick     = 0
+	storedAssetDecimals      uint32
+	storedQuBalanceDeltas    []db.Delta
+	writesOutsideTransaction = 0
 )
 
 type FakeEventClient struct {
@@ -36,8 +46,12 @@ func (eventClient *FakeEventClient) GetEvents(_ context.Context, tickNumber uint
 	return eventClient.events[tickNumber], nil
 }
 
+func (eventClient *FakeEventClient) GetTickData(_ context.Context, _ uint32) (*client.TickData, error) {
+	return &client.TickData{Timestamp: time.Now()}, nil
+}
+
 func (eventClient *FakeEventClient) GetTickInfo(_ context.Context) (*client.TickInfo, error) {
-	return &client.TickInfo{CurrentTick: uint32(liveTick)}, nil
+	return &client.TickInfo{CurrentTick: uint32(liveTick), InitialTick: 1}, nil
 }
 
 type FakeRepository struct {
@@ -47,7 +61,25 @@ func (f FakeRepository) GetLatestTick(_ context.Context) (int, error) {
 	return processedTestTick, nil
 }
 
-func (f FakeRepository) UpdateLatestTick(_ context.Context, tickNumber int) error {
+func (f FakeRepository) UpdateEpochStartTick(_ context.Context, tickNumber int) error {
+	storedEpochStartTick = tickNumber
+	return nil
+}
+
+type fakeTransactionKey struct{}
+
+func (f FakeRepository) InTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(context.WithValue(ctx, fakeTransactionKey{}, true))
+}
+
+func countWriteOutsideTransaction(ctx context.Context) {
+	if ctx.Value(fakeTransactionKey{}) == nil {
+		writesOutsideTransaction++
+	}
+}
+
+func (f FakeRepository) CommitTick(ctx context.Context, tickNumber int) error {
+	countWriteOutsideTransaction(ctx)
 	processedTestTick = tickNumber
 	return nil
 }
@@ -60,7 +92,12 @@ func (f FakeRepository) GetOrCreateAsset(_ context.Context, _, _ string) (int, e
 	return rand.IntN(1000), nil
 }
 
-func (f FakeRepository) GetOrCreateAssetChangeEvent(_ context.Context, _, _, _, _ int, _ int64) (int, error) {
+func (f FakeRepository) UpdateAssetDecimals(_ context.Context, _ int, numberOfDecimals uint32, _ string) error {
+	storedAssetDecimals = numberOfDecimals
+	return nil
+}
+
+func (f FakeRepository) GetOrCreateAssetChangeEvent(_ context.Context, _, _, _, _ int, _ uint64) (int, error) {
 	return rand.IntN(1000), nil
 }
 
@@ -68,11 +105,27 @@ func (f FakeRepository) GetOrCreateEntity(_ context.Context, _ string) (int, err
 	return rand.IntN(1000), nil
 }
 
-func (f FakeRepository) GetOrCreateQuTransferEvent(_ context.Context, _ int, _ int, _ int, _ uint64) (int, error) {
+func (f FakeRepository) GetOrCreateQuTransferEvent(ctx context.Context, _ int, _ int, _ int, _ uint64) (int, error) {
+	countWriteOutsideTransaction(ctx)
 	storedQuTransferEvents++
 	return rand.IntN(1000), nil
 }
 
+func (f FakeRepository) GetOrCreateQuBalanceChange(ctx context.Context, _, _ int, delta db.Delta) (int, error) {
+	countWriteOutsideTransaction(ctx)
+	storedQuBalanceChanges++
+	storedQuBalanceDeltas = append(storedQuBalanceDeltas, delta)
+	return rand.IntN(1000), nil
+}
+
+func (f FakeRepository) UpdateAssetOwnership(_ context.Context, _, _, _ int, _ db.Delta) error {
+	return nil
+}
+
+func (f FakeRepository) UpdateAssetPossession(_ context.Context, _, _, _ int, _ db.Delta) error {
+	return nil
+}
+
 func (f FakeRepository) GetOrCreateEvent(_ context.Context, _ int, _ uint64, _ uint32, _ string) (int, error) {
 	return rand.IntN(1000), nil
 }
@@ -81,7 +134,7 @@ func (f FakeRepository) GetOrCreateTransaction(_ context.Context, _ string, _ in
 	return rand.IntN(1000), nil
 }
 
-func (f FakeRepository) GetOrCreateTick(_ context.Context, _ uint32) (int, error) {
+func (f FakeRepository) GetOrCreateTick(_ context.Context, _ uint32, _ time.Time, _ uint32) (int, error) {
 	return rand.IntN(1000), nil
 }
 
@@ -98,6 +151,13 @@ func (fm *FakeMetrics) SetLatestLiveTick(tick uint32) {
 	metricLiveTick = tick
 }
 
+type FakeTickPublisher struct {
+}
+
+func (fp *FakeTickPublisher) Publish(tick uint32) {
+	publishedTicks = append(publishedTicks, tick)
+}
+
 //goland:noinspection SpellCheckingInspection
 func TestEventService_ProcessTickEvents(t *testing.T) {
 	slog.SetLogLevel(slog.DebugLevel)
@@ -130,7 +190,9 @@ func TestEventService_ProcessTickEvents(t *testing.T) {
 	processedTestTick = 122
 	eventTick = 125
 	liveTick = 126
-	eventService, err := NewEventService(fakeEventClient, &eventProcessor, fakeRepo, &FakeMetrics{})
+	publishedTicks = nil
+	writesOutsideTransaction = 0
+	eventService, err := NewEventService(fakeEventClient, &eventProcessor, fakeRepo, &FakeMetrics{}, &FakeTickPublisher{})
 	assert.NoError(t, err)
 
 	err = eventService.sync(42)
@@ -138,6 +200,8 @@ func TestEventService_ProcessTickEvents(t *testing.T) {
 
 	assert.Equal(t, 4, storedQuTransferEvents)
 	assert.Equal(t, 125, processedTestTick)
+	assert.Equal(t, []uint32{123, 124, 125}, publishedTicks)
+	assert.Equal(t, 0, writesOutsideTransaction)
 }
 
 func TestEventService_SetMetricCounters(t *testing.T) {
@@ -158,7 +222,7 @@ func TestEventService_SetMetricCounters(t *testing.T) {
 	processedTestTick = 122
 	eventTick = 130
 	liveTick = 123
-	eventService, err := NewEventService(fakeEventClient, &eventProcessor, &FakeRepository{}, &FakeMetrics{})
+	eventService, err := NewEventService(fakeEventClient, &eventProcessor, &FakeRepository{}, &FakeMetrics{}, &FakeTickPublisher{})
 	assert.NoError(t, err)
 
 	err = eventService.sync(42)
@@ -170,6 +234,92 @@ func TestEventService_SetMetricCounters(t *testing.T) {
 
 }
 
+func TestEventService_StoreEpochStartTick(t *testing.T) {
+	eventService, err := NewEventService(&FakeEventClient{}, &EventProcessor{}, &FakeRepository{}, &FakeMetrics{}, &FakeTickPublisher{})
+	assert.NoError(t, err)
+
+	storedEpochStartTick = 0
+	_, _, err = eventService.calculateStartTick(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, storedEpochStartTick)
+
+	storedEpochStartTick = 0 // only stored on change
+	_, _, err = eventService.calculateStartTick(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, storedEpochStartTick)
+}
+
+func TestEventProcessor_StoreQuBalanceChanges(t *testing.T) {
+	eventProcessor := EventProcessor{
+		repository: &FakeRepository{},
+	}
+
+	storedQuBalanceChanges = 0
+	err := eventProcessor.storeQuBalanceChanges(context.Background(), 1, 2, 3, 1000)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, storedQuBalanceChanges)
+
+	storedQuBalanceChanges = 0
+	err = eventProcessor.storeQuBalanceChanges(context.Background(), 1, 2, 2, 1000)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, storedQuBalanceChanges, "transfer to self should not change balance")
+}
+
+//goland:noinspection SpellCheckingInspection
+func TestEventProcessor_StoreQuTransferEvent_givenAmountAboveInt64_thenStoreBalanceChanges(t *testing.T) {
+	eventProcessor := EventProcessor{
+		repository: &FakeRepository{},
+	}
+	eventData, err := base64.StdEncoding.DecodeString("sMmo18V9WMO9LstUtxvWC2ZfJc2/FZWKEUdAKOqNKDIBAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAEBCDwAAAAAA")
+	assert.NoError(t, err)
+	copy(eventData[64:72], []byte{0, 0, 0, 0, 0, 0, 0, 0x80}) // max int64 + 1
+
+	storedQuBalanceDeltas = nil
+	_, err = eventProcessor.storeQuTransferEvent(context.Background(), eventData, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []db.Delta{db.Debit(math.MaxInt64 + 1), db.Credit(math.MaxInt64 + 1)}, storedQuBalanceDeltas)
+}
+
+func TestEventProcessor_UpdateAssetHoldings(t *testing.T) {
+	deltas := map[int]db.Delta{}
+	update := func(_ context.Context, _, _, entityId int, delta db.Delta) error {
+		deltas[entityId] = delta
+		return nil
+	}
+
+	err := updateAssetHoldings(context.Background(), update, 1, 2, 3, 4, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]db.Delta{3: db.Debit(100), 4: db.Credit(100)}, deltas)
+
+	clear(deltas)
+	err = updateAssetHoldings(context.Background(), update, 1, 2, 3, 4, math.MaxUint64)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]db.Delta{3: db.Debit(math.MaxUint64), 4: db.Credit(math.MaxUint64)}, deltas)
+
+	clear(deltas)
+	err = updateAssetHoldings(context.Background(), update, 1, 2, 3, 3, 100)
+	assert.NoError(t, err)
+	assert.Empty(t, deltas, "transfer to self should not change holdings")
+}
+
+//goland:noinspection SpellCheckingInspection
+func TestEventProcessor_StoreAssetOwnershipChangeEvent(t *testing.T) {
+	eventProcessor := EventProcessor{
+		repository: &FakeRepository{},
+	}
+	eventData, err := base64.StdEncoding.DecodeString("QvMt7n7vPwdDhVUbxbRVOxMpx/7trku3V9udvL77Hfm0XNyWnewpiwi3DPqGYe9p1T1ee0dgKChsGN91xWt9RAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAAAAAAAAAKAAAAAAAAAE1MTQAAAAAAAAAAAAAAAA==")
+	assert.NoError(t, err)
+	eventData[119] = 2 // number of decimals
+
+	_, err = eventProcessor.storeAssetOwnershipChangeEvent(context.Background(), eventData, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2), storedAssetDecimals)
+
+	copy(eventData[96:104], []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}) // -1 shares
+	_, err = eventProcessor.storeAssetOwnershipChangeEvent(context.Background(), eventData, 1)
+	assert.ErrorContains(t, err, "negative number of shares")
+}
+
 func event(eventType uint32, eventData string, header *eventspb.Event_Header) eventspb.Event {
 	return eventspb.Event{
 		Header:    header,
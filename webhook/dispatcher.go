@@ -0,0 +1,162 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"go-transfers/db"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gookit/slog"
+	"github.com/pkg/errors"
+)
+
+const (
+	HeaderId          = "X-Webhook-Id"
+	HeaderTimestamp   = "X-Webhook-Timestamp"
+	HeaderSignature   = "X-Webhook-Signature"
+	batchSize         = 100
+	perWebhookLimit   = 4 // concurrent deliveries per webhook
+	leaseMargin       = time.Minute
+	initialBackoff    = 10 * time.Second
+	maxBackoff        = time.Hour
+	maxResponseLength = 64 * 1024
+)
+
+type Repository interface {
+	ClaimDueWebhookNotifications(ctx context.Context, limit, perWebhook int, lease time.Duration) ([]db.WebhookNotification, error)
+	RecordWebhookDelivery(ctx context.Context, delivery db.WebhookDelivery, status string, nextAttempt time.Time) error
+}
+
+// Dispatcher delivers the queued webhook notifications.
+type Dispatcher struct {
+	repository  Repository
+	client      *http.Client
+	maxAttempts int
+}
+
+func NewDispatcher(repository Repository, timeout time.Duration, maxAttempts int) *Dispatcher {
+	return &Dispatcher{
+		repository:  repository,
+		client:      &http.Client{Timeout: timeout},
+		maxAttempts: maxAttempts,
+	}
+}
+
+func (d *Dispatcher) DispatchInLoop() {
+	loopTick := time.Tick(time.Second)
+	for range loopTick {
+		count, err := d.dispatch(context.Background())
+		if err != nil {
+			slog.Error("dispatching webhook notifications", "err", err.Error())
+		} else if count > 0 {
+			slog.Debug("Dispatched webhook notifications.", "count", count)
+		}
+	}
+}
+
+// dispatch claims the due notifications, delivers them concurrently and returns the number of delivery attempts. Only
+// a few notifications are claimed per webhook, so that slow endpoints don't delay the other webhooks.
+func (d *Dispatcher) dispatch(ctx context.Context) (int, error) {
+	lease := d.client.Timeout + leaseMargin
+	notifications, err := d.repository.ClaimDueWebhookNotifications(ctx, batchSize, perWebhookLimit, lease)
+	if err != nil {
+		return 0, errors.Wrap(err, "claiming due notifications")
+	}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	var firstErr error
+	for _, notification := range notifications {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := d.deliver(ctx, notification)
+			if err != nil {
+				mutex.Lock()
+				defer mutex.Unlock()
+				if firstErr == nil {
+					firstErr = errors.Wrapf(err, "delivering notification [%d]", notification.Id)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return len(notifications), firstErr
+}
+
+// deliver posts the notification and records the attempt. Failed deliveries are retried with exponential backoff
+// until the maximum number of attempts is reached.
+func (d *Dispatcher) deliver(ctx context.Context, notification db.WebhookNotification) error {
+	start := time.Now()
+	statusCode, err := d.post(ctx, notification)
+	delivery := db.WebhookDelivery{
+		OutboxId:   notification.Id,
+		Attempt:    notification.Attempts + 1,
+		StatusCode: statusCode,
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+
+	status := db.WebhookStatusDelivered
+	nextAttempt := time.Now()
+	if err != nil {
+		delivery.Error = err.Error()
+		status = db.WebhookStatusPending
+		nextAttempt = nextAttempt.Add(backoff(delivery.Attempt))
+		if delivery.Attempt >= d.maxAttempts {
+			status = db.WebhookStatusFailed
+		}
+		slog.Warn("Webhook delivery failed.", "notification", notification.Id, "attempt", delivery.Attempt, "error", err)
+	}
+	return d.repository.RecordWebhookDelivery(ctx, delivery, status, nextAttempt)
+}
+
+// post sends the payload and returns the status code of the response or 0, if there is none.
+func (d *Dispatcher) post(ctx context.Context, notification db.WebhookNotification) (int, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, notification.Url, bytes.NewReader(notification.Payload))
+	if err != nil {
+		return 0, errors.Wrap(err, "creating request")
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(HeaderId, strconv.FormatInt(notification.Id, 10))
+	request.Header.Set(HeaderTimestamp, timestamp)
+	request.Header.Set(HeaderSignature, "sha256="+Sign(notification.Secret, timestamp, notification.Payload))
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		return 0, errors.Wrap(err, "sending request")
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, maxResponseLength)) // allow connection reuse
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return response.StatusCode, errors.Errorf("unexpected status code [%d]", response.StatusCode)
+	}
+	return response.StatusCode, nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of 'timestamp.payload'. Receivers should verify the signature and reject
+// old timestamps to prevent replays.
+func Sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// backoff doubles the delay with every attempt up to the maximum.
+func backoff(attempt int) time.Duration {
+	delay := initialBackoff
+	for i := 1; i < attempt && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxBackoff)
+}
@@ -0,0 +1,158 @@
+package webhook
+
+import (
+	"context"
+	"go-transfers/db"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordedDelivery struct {
+	delivery    db.WebhookDelivery
+	status      string
+	nextAttempt time.Time
+}
+
+type FakeRepository struct {
+	mutex         sync.Mutex
+	notifications []db.WebhookNotification
+	deliveries    []recordedDelivery
+	perWebhook    int
+	lease         time.Duration
+}
+
+func (f *FakeRepository) ClaimDueWebhookNotifications(_ context.Context, _, perWebhook int, lease time.Duration) ([]db.WebhookNotification, error) {
+	f.perWebhook, f.lease = perWebhook, lease
+	return f.notifications, nil
+}
+
+func (f *FakeRepository) RecordWebhookDelivery(_ context.Context, delivery db.WebhookDelivery, status string, nextAttempt time.Time) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.deliveries = append(f.deliveries, recordedDelivery{delivery, status, nextAttempt})
+	return nil
+}
+
+// delivery returns the recorded delivery of the notification. Deliveries are recorded in any order.
+func (f *FakeRepository) delivery(t *testing.T, outboxId int64) recordedDelivery {
+	for _, delivery := range f.deliveries {
+		if delivery.delivery.OutboxId == outboxId {
+			return delivery
+		}
+	}
+	require.Fail(t, "missing delivery", "notification [%d]", outboxId)
+	return recordedDelivery{}
+}
+
+func TestDispatcher_Dispatch_thenSignAndRecordDelivery(t *testing.T) {
+	var received *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	repository := &FakeRepository{notifications: []db.WebhookNotification{
+		{Id: 42, Url: server.URL, Secret: "secret", Payload: []byte(`{"tick":1}`)},
+	}}
+	count, err := NewDispatcher(repository, time.Second, 3).dispatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	assert.Equal(t, `{"tick":1}`, string(body))
+	assert.Equal(t, "42", received.Header.Get(HeaderId))
+	timestamp := received.Header.Get(HeaderTimestamp)
+	assert.Equal(t, "sha256="+Sign("secret", timestamp, body), received.Header.Get(HeaderSignature))
+
+	require.Len(t, repository.deliveries, 1)
+	assert.Equal(t, db.WebhookStatusDelivered, repository.deliveries[0].status)
+	assert.Equal(t, 1, repository.deliveries[0].delivery.Attempt)
+	assert.Equal(t, http.StatusOK, repository.deliveries[0].delivery.StatusCode)
+	assert.Empty(t, repository.deliveries[0].delivery.Error)
+}
+
+func TestDispatcher_Dispatch_givenError_thenRetryUntilFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	repository := &FakeRepository{notifications: []db.WebhookNotification{
+		{Id: 1, Url: server.URL, Attempts: 0},
+		{Id: 2, Url: server.URL, Attempts: 2},
+	}}
+	_, err := NewDispatcher(repository, time.Second, 3).dispatch(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, repository.deliveries, 2)
+	retry := repository.delivery(t, 1)
+	assert.Equal(t, db.WebhookStatusPending, retry.status)
+	assert.Equal(t, http.StatusServiceUnavailable, retry.delivery.StatusCode)
+	assert.NotEmpty(t, retry.delivery.Error)
+	assert.True(t, retry.nextAttempt.After(time.Now().Add(initialBackoff/2)))
+
+	failed := repository.delivery(t, 2)
+	assert.Equal(t, db.WebhookStatusFailed, failed.status)
+	assert.Equal(t, 3, failed.delivery.Attempt)
+}
+
+func TestDispatcher_Dispatch_givenSlowEndpoint_thenDeliverConcurrently(t *testing.T) {
+	release := make(chan struct{})
+	var mutex sync.Mutex
+	var inFlight, maxInFlight int
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mutex.Unlock()
+		<-release
+	}))
+	defer slow.Close()
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer fast.Close()
+
+	repository := &FakeRepository{notifications: []db.WebhookNotification{
+		{Id: 1, Url: slow.URL},
+		{Id: 2, Url: slow.URL},
+		{Id: 3, Url: fast.URL},
+	}}
+	done := make(chan error)
+	go func() {
+		_, err := NewDispatcher(repository, 5*time.Second, 3).dispatch(context.Background())
+		done <- err
+	}()
+
+	require.Eventually(t, func() bool {
+		repository.mutex.Lock()
+		defer repository.mutex.Unlock()
+		return len(repository.deliveries) == 1 // fast endpoint not blocked by the slow one
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, db.WebhookStatusDelivered, repository.delivery(t, 3).status)
+	close(release)
+	require.NoError(t, <-done)
+
+	require.Len(t, repository.deliveries, 3)
+	assert.Equal(t, 2, maxInFlight)
+	assert.Equal(t, perWebhookLimit, repository.perWebhook)
+	assert.Equal(t, 5*time.Second+leaseMargin, repository.lease)
+}
+
+func Test_Backoff(t *testing.T) {
+	assert.Equal(t, 10*time.Second, backoff(1))
+	assert.Equal(t, 20*time.Second, backoff(2))
+	assert.Equal(t, 80*time.Second, backoff(4))
+	assert.Equal(t, time.Hour, backoff(100))
+}
+
+func Test_Sign(t *testing.T) {
+	// echo -n '1700000000.{}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(t, "b8569b78799ff9e3cbff0fc2d63a33a2b57f3282abd07c37ae5e8e7d79a5f163", Sign("secret", "1700000000", []byte("{}")))
+}